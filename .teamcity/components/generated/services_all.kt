@@ -106,6 +106,7 @@ val services = mapOf(
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
     "iotevents" to ServiceSpec("IoT Events"),
+    "iotsitewise" to ServiceSpec("IoT SiteWise"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
     "kafka" to ServiceSpec("Managed Streaming for Kafka", vpcLock = true),
@@ -150,6 +151,7 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "resiliencehub" to ServiceSpec("Resilience Hub"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -198,6 +200,7 @@ val services = mapOf(
     "wafregional" to ServiceSpec("WAF Classic Regional"),
     "wafv2" to ServiceSpec("WAF"),
     "worklink" to ServiceSpec("WorkLink"),
+    "workmail" to ServiceSpec("WorkMail"),
     "workspaces" to ServiceSpec("WorkSpaces", vpcLock = true),
     "xray" to ServiceSpec("X-Ray"),
 )
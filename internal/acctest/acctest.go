@@ -1244,6 +1244,19 @@ provider "aws" {
 `, key1)
 }
 
+// ConfigRefreshDetail creates a new provider configuration with the specified refresh detail.
+//
+// This can only be used for single provider configuration testing as it
+// overwrites the "aws" provider configuration.
+func ConfigRefreshDetail(detail string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  refresh_detail = %[1]q
+}
+`, detail)
+}
+
 // ConfigRegionalProvider creates a new provider configuration with a region.
 //
 // This can only be used for single provider configuration testing as it
@@ -33,14 +33,26 @@ type AWSClient struct {
 	Session                 *session_sdkv1.Session
 	TerraformVersion        string
 
-	awsConfig      *aws_sdkv2.Config
-	clients        map[string]any
-	conns          map[string]any
-	endpoints      map[string]string // From provider configuration.
-	httpClient     *http.Client
-	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	accountIDConfigured           bool // From provider configuration.
+	awsConfig                     *aws_sdkv2.Config
+	clients                       map[string]any
+	conns                         map[string]any
+	endpointHTTPClients           map[string]*endpointHTTPClient
+	endpoints                     map[string]string // From provider configuration.
+	httpClient                    *http.Client
+	lock                          sync.Mutex
+	refreshDetail                 string // From provider configuration.
+	s3UsePathStyle                bool   // From provider configuration.
+	stsRegion                     string // From provider configuration.
+	tagsCache                     *resourceTagsCache
+	useDualStackEndpointOverrides map[string]bool // From provider configuration.
+	useFIPSEndpointOverrides      map[string]bool // From provider configuration.
+}
+
+// AccountIDConfigured returns whether the account ID was set in the provider configuration
+// rather than determined by calling AWS APIs.
+func (client *AWSClient) AccountIDConfigured() bool {
+	return client.accountIDConfigured
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -136,11 +148,13 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+	sess, awsConfig := client.endpointStateOverrides(servicePackageName)
+	sess, awsConfig = client.endpointHTTPClientOverrides(servicePackageName, sess, awsConfig)
 	m := map[string]any{
-		"aws_sdkv2_config": client.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         client.endpoints[servicePackageName],
 		"partition":        client.Partition,
-		"session":          client.Session,
+		"session":          sess,
 	}
 	switch servicePackageName {
 	case names.S3:
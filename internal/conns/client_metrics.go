@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	middleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	awserr_sdkv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	clientMetricsExportInterval = 10 * time.Second
+	clientMetricsMeterName      = "github.com/hashicorp/terraform-provider-aws"
+	clientMetricsServiceName    = "terraform-provider-aws"
+)
+
+var (
+	clientMetricsLock       sync.Mutex
+	clientMetricsByEndpoint = make(map[string]*clientMetrics)
+)
+
+// clientMetrics records per-service AWS API call metrics and exports them as OTLP metrics.
+type clientMetrics struct {
+	provider  *sdkmetric.MeterProvider
+	calls     metric.Int64Counter
+	duration  metric.Float64Histogram
+	retries   metric.Int64Counter
+	throttles metric.Int64Counter
+}
+
+// clientMetricsForEndpoint returns the client metrics exporting to the specified OTLP endpoint.
+// The provider may be configured many times in one process, so metrics are shared per endpoint.
+func clientMetricsForEndpoint(ctx context.Context, endpoint string) (*clientMetrics, error) {
+	clientMetricsLock.Lock()
+	defer clientMetricsLock.Unlock()
+
+	if metrics, ok := clientMetricsByEndpoint[endpoint]; ok {
+		return metrics, nil
+	}
+
+	metrics, err := newClientMetrics(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	clientMetricsByEndpoint[endpoint] = metrics
+
+	return metrics, nil
+}
+
+func newClientMetrics(ctx context.Context, endpoint string) (*clientMetrics, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing OTLP endpoint (%s): %w", endpoint, err)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("OTLP endpoint (%s) must be an absolute URL", endpoint)
+	}
+
+	opts := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithEndpoint(u.Host),
+	}
+
+	switch u.Scheme {
+	case "http":
+		opts = append(opts, otlpmetrichttp.WithInsecure())
+	case "https":
+	default:
+		return nil, fmt.Errorf("OTLP endpoint (%s) must use the http or https scheme", endpoint)
+	}
+
+	if u.Path != "" && u.Path != "/" {
+		opts = append(opts, otlpmetrichttp.WithURLPath(u.Path))
+	}
+
+	exporter, err := otlpmetrichttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP metrics exporter: %w", err)
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(clientMetricsExportInterval))),
+		sdkmetric.WithResource(resource.NewSchemaless(attribute.String("service.name", clientMetricsServiceName))),
+	)
+
+	meter := provider.Meter(clientMetricsMeterName)
+	metrics := &clientMetrics{
+		provider: provider,
+	}
+
+	if metrics.calls, err = meter.Int64Counter("aws.api.calls",
+		metric.WithDescription("Number of AWS API calls."),
+		metric.WithUnit("{call}"),
+	); err != nil {
+		return nil, errors.Join(err, provider.Shutdown(ctx))
+	}
+
+	if metrics.duration, err = meter.Float64Histogram("aws.api.duration",
+		metric.WithDescription("Duration of AWS API calls, including retries."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, errors.Join(err, provider.Shutdown(ctx))
+	}
+
+	if metrics.retries, err = meter.Int64Counter("aws.api.retries",
+		metric.WithDescription("Number of AWS API call retries."),
+		metric.WithUnit("{retry}"),
+	); err != nil {
+		return nil, errors.Join(err, provider.Shutdown(ctx))
+	}
+
+	if metrics.throttles, err = meter.Int64Counter("aws.api.throttles",
+		metric.WithDescription("Number of AWS API call attempts that were throttled."),
+		metric.WithUnit("{attempt}"),
+	); err != nil {
+		return nil, errors.Join(err, provider.Shutdown(ctx))
+	}
+
+	return metrics, nil
+}
+
+// ShutdownClientMetrics flushes and stops all AWS API client metrics exporters.
+func ShutdownClientMetrics(ctx context.Context) error {
+	clientMetricsLock.Lock()
+	defer clientMetricsLock.Unlock()
+
+	var errs []error
+
+	for endpoint, metrics := range clientMetricsByEndpoint {
+		if err := metrics.provider.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+
+		delete(clientMetricsByEndpoint, endpoint)
+	}
+
+	return errors.Join(errs...)
+}
+
+// instrument registers metrics collection with the AWS SDK for Go v1 session and v2 configuration.
+func (m *clientMetrics) instrument(cfg *aws_sdkv2.Config, sess *session_sdkv1.Session) {
+	sess.Handlers.CompleteAttempt.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf-aws-client-metrics-attempt",
+		Fn:   m.completeAttemptSDKv1,
+	})
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf-aws-client-metrics",
+		Fn:   m.completeSDKv1,
+	})
+
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAWSClientMetrics", m.handleInitializeSDKv2), middleware.After)
+	})
+}
+
+func (m *clientMetrics) completeAttemptSDKv1(r *request_sdkv1.Request) {
+	if request_sdkv1.IsErrorThrottle(r.Error) {
+		m.throttles.Add(r.Context(), 1, metric.WithAttributes(clientMetricsAttributes(r.ClientInfo.ServiceID, r.Operation.Name, "")...))
+	}
+}
+
+func (m *clientMetrics) completeSDKv1(r *request_sdkv1.Request) {
+	var errorCode string
+	if err, ok := r.Error.(awserr_sdkv1.Error); ok {
+		errorCode = err.Code()
+	} else if r.Error != nil {
+		errorCode = "unknown"
+	}
+
+	m.record(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name, errorCode, time.Since(r.Time), r.RetryCount, 0)
+}
+
+func (m *clientMetrics) handleInitializeSDKv2(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	var errorCode string
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			errorCode = apiErr.ErrorCode()
+		} else {
+			errorCode = "unknown"
+		}
+	}
+
+	var retries, throttles int
+	if results, ok := retry_sdkv2.GetAttemptResults(metadata); ok {
+		if n := len(results.Results); n > 0 {
+			retries = n - 1
+		}
+
+		for _, result := range results.Results {
+			if retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles).IsErrorThrottle(result.Err) == aws_sdkv2.TrueTernary {
+				throttles++
+			}
+		}
+	}
+
+	m.record(ctx, middleware_sdkv2.GetServiceID(ctx), middleware_sdkv2.GetOperationName(ctx), errorCode, time.Since(start), retries, throttles)
+
+	return out, metadata, err
+}
+
+func (m *clientMetrics) record(ctx context.Context, service, operation, errorCode string, duration time.Duration, retries, throttles int) {
+	attrs := metric.WithAttributes(clientMetricsAttributes(service, operation, errorCode)...)
+
+	m.calls.Add(ctx, 1, attrs)
+	m.duration.Record(ctx, duration.Seconds(), attrs)
+
+	if retries > 0 {
+		m.retries.Add(ctx, int64(retries), attrs)
+	}
+
+	if throttles > 0 {
+		m.throttles.Add(ctx, int64(throttles), metric.WithAttributes(clientMetricsAttributes(service, operation, "")...))
+	}
+}
+
+func clientMetricsAttributes(service, operation, errorCode string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "aws-api"),
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", operation),
+	}
+
+	if errorCode != "" {
+		attrs = append(attrs, attribute.String("aws.error_code", errorCode))
+	}
+
+	return attrs
+}
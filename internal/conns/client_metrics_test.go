@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewClientMetrics_invalidEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name     string
+		Endpoint string
+	}{
+		{
+			Name:     "no scheme",
+			Endpoint: "localhost:4318",
+		},
+		{
+			Name:     "relative",
+			Endpoint: "/v1/metrics",
+		},
+		{
+			Name:     "unsupported scheme",
+			Endpoint: "grpc://localhost:4317",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := newClientMetrics(ctx, testCase.Endpoint); err == nil {
+				t.Errorf("expected error for endpoint %q", testCase.Endpoint)
+			}
+		})
+	}
+}
+
+func TestClientMetricsForEndpoint(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	metrics1, err := clientMetricsForEndpoint(ctx, "http://localhost:4318")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	metrics2, err := clientMetricsForEndpoint(ctx, "http://localhost:4318")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if metrics1 != metrics2 {
+		t.Error("expected client metrics to be reused for the same endpoint")
+	}
+
+	metrics3, err := clientMetricsForEndpoint(ctx, "http://localhost:4319")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if metrics1 == metrics3 {
+		t.Error("expected separate client metrics for different endpoints")
+	}
+
+	// Nothing is listening on the endpoints, so flushing may fail; only check that the exporters are released.
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+
+	ShutdownClientMetrics(ctx) //nolint:errcheck
+
+	clientMetricsLock.Lock()
+	n := len(clientMetricsByEndpoint)
+	clientMetricsLock.Unlock()
+
+	if n != 0 {
+		t.Errorf("expected no client metrics after shutdown, got %d", n)
+	}
+}
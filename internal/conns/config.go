@@ -20,21 +20,27 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	AccountID                      string
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BulkTagReads                   bool
+	ClientMetricsOTLPEndpoint      string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointHTTPConfigs            map[string]EndpointHTTPConfig
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	Partition                      string
 	Profile                        string
+	RefreshDetail                  string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -49,7 +55,9 @@ type Config struct {
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
+	UseDualStackEndpointOverrides  map[string]bool
 	UseFIPSEndpoint                bool
+	UseFIPSEndpointOverrides       map[string]bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -79,6 +87,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
+	if c.AccountID != "" {
+		// Offline mode: no STS or IAM calls are made to validate credentials or look up the account.
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+	}
+
 	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
 		awsbaseConfig.AssumeRole = c.AssumeRole
 	}
@@ -123,10 +137,33 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
-	tflog.Debug(ctx, "Retrieving AWS account details")
-	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
-	if err != nil {
-		return nil, diag.Errorf("retrieving AWS account details: %s", err)
+	if c.ClientMetricsOTLPEndpoint != "" {
+		tflog.Debug(ctx, "Configuring AWS API client metrics")
+		metrics, err := clientMetricsForEndpoint(ctx, c.ClientMetricsOTLPEndpoint)
+		if err != nil {
+			return nil, diag.Errorf("configuring AWS API client metrics: %s", err)
+		}
+		metrics.instrument(&cfg, sess)
+	}
+
+	var accountID, partition string
+	if c.AccountID != "" {
+		// Offline mode: take the account ID and partition from configuration, deriving the partition from the Region if unset.
+		tflog.Debug(ctx, "Using configured AWS account ID")
+		accountID = c.AccountID
+		partition = c.Partition
+		if partition == "" {
+			partition = endpoints_sdkv1.AwsPartitionID
+			if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
+				partition = p.ID()
+			}
+		}
+	} else {
+		tflog.Debug(ctx, "Retrieving AWS account details")
+		accountID, partition, err = awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
+		if err != nil {
+			return nil, diag.Errorf("retrieving AWS account details: %s", err)
+		}
 	}
 
 	if accountID == "" {
@@ -160,6 +197,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	client.accountIDConfigured = c.AccountID != ""
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
@@ -175,8 +213,35 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	for servicePackageName, v := range c.EndpointHTTPConfigs {
+		httpClient, err := newEndpointHTTPClient(sess, &cfg, c.HTTPProxy, v)
+		if err != nil {
+			return nil, diag.Errorf("configuring HTTP client for %s: %s", servicePackageName, err)
+		}
+		if client.endpointHTTPClients == nil {
+			client.endpointHTTPClients = make(map[string]*endpointHTTPClient)
+		}
+		client.endpointHTTPClients[servicePackageName] = httpClient
+	}
+	client.refreshDetail = c.RefreshDetail
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.stsRegion = c.STSRegion
+	client.useDualStackEndpointOverrides = c.UseDualStackEndpointOverrides
+	client.useFIPSEndpointOverrides = c.UseFIPSEndpointOverrides
+	if c.BulkTagReads {
+		client.tagsCache = &resourceTagsCache{}
+	}
 
 	return client, nil
 }
+
+// PartitionIDs returns the IDs of the AWS partitions known to the provider.
+func PartitionIDs() []string {
+	var ids []string
+
+	for _, p := range endpoints_sdkv1.DefaultPartitions() {
+		ids = append(ids, p.ID())
+	}
+
+	return ids
+}
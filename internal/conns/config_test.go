@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigureProvider_accountIDSkipsSTS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Setenv("AWS_CONFIG_FILE", "file_not_exists")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "file_not_exists")
+
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	c := &Config{
+		AccessKey: "StaticAccessKey",
+		AccountID: "123456789012",
+		Endpoints: map[string]string{
+			names.IAM: ts.URL,
+			names.STS: ts.URL,
+		},
+		MaxRetries: 1,
+		Region:     "us-west-2", //lintignore:AWSAT003
+		SecretKey:  "StaticSecretKey",
+	}
+
+	client, diags := c.ConfigureProvider(context.Background(), &AWSClient{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := client.AccountID, "123456789012"; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+
+	if got, want := client.Partition, "aws"; got != want {
+		t.Errorf("Partition = %q, want %q", got, want)
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Errorf("expected no STS or IAM requests, got %d", n)
+	}
+}
+
+func TestConfigureProvider_accountIDPartition(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Setenv("AWS_CONFIG_FILE", "file_not_exists")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "file_not_exists")
+
+	c := &Config{
+		AccessKey:  "StaticAccessKey",
+		AccountID:  "123456789012",
+		MaxRetries: 1,
+		Partition:  "aws-us-gov",
+		Region:     "us-west-2", //lintignore:AWSAT003
+		SecretKey:  "StaticSecretKey",
+	}
+
+	client, diags := c.ConfigureProvider(context.Background(), &AWSClient{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := client.Partition, "aws-us-gov"; got != want {
+		t.Errorf("Partition = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// EndpointHTTPConfig holds per-service overrides of the provider's HTTP settings.
+type EndpointHTTPConfig struct {
+	CustomCABundle string
+	HTTPProxy      string
+	HTTPSProxy     string
+	NoProxy        string
+}
+
+// endpointHTTPClient holds the HTTP clients used by a service's AWS SDK for Go v1 and v2 API clients.
+type endpointHTTPClient struct {
+	sdkv1 *http.Client
+	sdkv2 aws_sdkv2.HTTPClient
+}
+
+// newEndpointHTTPClient returns HTTP clients based on the provider's HTTP clients with the specified overrides applied.
+// httpProxy is the provider-level HTTP proxy, if any.
+func newEndpointHTTPClient(sess *session_sdkv1.Session, cfg *aws_sdkv2.Config, httpProxy string, c EndpointHTTPConfig) (*endpointHTTPClient, error) {
+	var rootCAs *x509.CertPool
+
+	if c.CustomCABundle != "" {
+		filename, err := homedir.Expand(c.CustomCABundle)
+		if err != nil {
+			return nil, fmt.Errorf("expanding custom CA bundle path (%s): %w", c.CustomCABundle, err)
+		}
+
+		pem, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("reading custom CA bundle (%s): %w", c.CustomCABundle, err)
+		}
+
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("custom CA bundle (%s) contains no valid certificates", c.CustomCABundle)
+		}
+	}
+
+	var proxy func(*http.Request) (*url.URL, error)
+
+	if c.HTTPProxy != "" || c.HTTPSProxy != "" || c.NoProxy != "" {
+		proxyConfig := providerProxyConfig(httpProxy)
+		if c.HTTPProxy != "" {
+			proxyConfig.HTTPProxy = c.HTTPProxy
+		}
+		if c.HTTPSProxy != "" {
+			proxyConfig.HTTPSProxy = c.HTTPSProxy
+		}
+		if c.NoProxy != "" {
+			proxyConfig.NoProxy = c.NoProxy
+		}
+		proxyFunc := proxyConfig.ProxyFunc()
+
+		proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	transportOptions := func(tr *http.Transport) {
+		if rootCAs != nil {
+			if tr.TLSClientConfig == nil {
+				tr.TLSClientConfig = &tls.Config{
+					MinVersion: tls.VersionTLS12,
+				}
+			} else {
+				tr.TLSClientConfig = tr.TLSClientConfig.Clone()
+			}
+			tr.TLSClientConfig.RootCAs = rootCAs
+		}
+
+		if proxy != nil {
+			tr.Proxy = proxy
+		}
+	}
+
+	client := &endpointHTTPClient{}
+
+	// AWS SDK for Go v1.
+	var transport *http.Transport
+	var timeout time.Duration
+	if httpClient := sess.Config.HTTPClient; httpClient != nil {
+		if v, ok := httpClient.Transport.(*http.Transport); ok {
+			transport = v.Clone()
+		}
+		timeout = httpClient.Timeout
+	}
+	if transport == nil {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	transportOptions(transport)
+	client.sdkv1 = &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+
+	// AWS SDK for Go v2.
+	if v, ok := cfg.HTTPClient.(*awshttp_sdkv2.BuildableClient); ok {
+		client.sdkv2 = v.WithTransportOptions(transportOptions)
+	} else {
+		client.sdkv2 = client.sdkv1
+	}
+
+	return client, nil
+}
+
+// providerProxyConfig returns the proxy configuration used by the provider's HTTP clients.
+// The provider-level HTTP proxy is used for all requests if set, otherwise the proxy environment variables apply.
+func providerProxyConfig(httpProxy string) *httpproxy.Config {
+	if httpProxy != "" {
+		return &httpproxy.Config{
+			HTTPProxy:  httpProxy,
+			HTTPSProxy: httpProxy,
+		}
+	}
+
+	return httpproxy.FromEnvironment()
+}
+
+// endpointHTTPClientOverrides returns copies of the specified AWS SDK for Go v1 session and v2 configuration
+// that use the service's HTTP clients, if any.
+func (client *AWSClient) endpointHTTPClientOverrides(servicePackageName string, sess *session_sdkv1.Session, cfg *aws_sdkv2.Config) (*session_sdkv1.Session, *aws_sdkv2.Config) {
+	httpClient, ok := client.endpointHTTPClients[servicePackageName]
+
+	if !ok {
+		return sess, cfg
+	}
+
+	if sess != nil {
+		sess = sess.Copy(&aws_sdkv1.Config{HTTPClient: httpClient.sdkv1})
+	}
+
+	if cfg != nil {
+		v := cfg.Copy()
+		v.HTTPClient = httpClient.sdkv2
+		cfg = &v
+	}
+
+	return sess, cfg
+}
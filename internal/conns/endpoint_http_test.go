@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestNewEndpointHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session_sdkv1.NewSession(&aws_sdkv1.Config{
+		HTTPClient: &http.Client{Transport: &http.Transport{}},
+		Region:     aws_sdkv1.String("us-west-2"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &aws_sdkv2.Config{
+		HTTPClient: awshttp_sdkv2.NewBuildableClient(),
+	}
+
+	client, err := newEndpointHTTPClient(sess, cfg, "", EndpointHTTPConfig{
+		HTTPSProxy: "http://proxy.example.com:3128",
+		NoProxy:    "sqs.us-west-2.amazonaws.com", //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transports := map[string]*http.Transport{
+		"v1": client.sdkv1.Transport.(*http.Transport),
+		"v2": client.sdkv2.(*awshttp_sdkv2.BuildableClient).GetTransport(),
+	}
+
+	for name, transport := range transports {
+		req, _ := http.NewRequest(http.MethodGet, "https://s3.us-west-2.amazonaws.com/", nil) //lintignore:AWSAT003
+		if u, err := transport.Proxy(req); err != nil || u == nil || u.Host != "proxy.example.com:3128" {
+			t.Errorf("%s: got proxy %v (%v), expected proxy.example.com:3128", name, u, err)
+		}
+
+		req, _ = http.NewRequest(http.MethodGet, "https://sqs.us-west-2.amazonaws.com/", nil) //lintignore:AWSAT003
+		if u, err := transport.Proxy(req); err != nil || u != nil {
+			t.Errorf("%s: got proxy %v (%v), expected none", name, u, err)
+		}
+	}
+
+	if sess.Config.HTTPClient.Transport.(*http.Transport).Proxy != nil {
+		t.Error("provider HTTP client modified")
+	}
+}
+
+func TestNewEndpointHTTPClient_providerProxy(t *testing.T) {
+	sess, err := session_sdkv1.NewSession(&aws_sdkv1.Config{
+		HTTPClient: &http.Client{Transport: &http.Transport{}},
+		Region:     aws_sdkv1.String("us-west-2"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		httpProxy string
+		env       map[string]string
+		config    EndpointHTTPConfig
+		expected  map[string]string
+	}{
+		"provider http_proxy with service no_proxy": {
+			httpProxy: "http://provider.example.com:3128",
+			config: EndpointHTTPConfig{
+				NoProxy: "sqs.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			},
+			expected: map[string]string{
+				"http://s3.us-west-2.amazonaws.com/":   "provider.example.com:3128", //lintignore:AWSAT003
+				"https://s3.us-west-2.amazonaws.com/":  "provider.example.com:3128", //lintignore:AWSAT003
+				"https://sqs.us-west-2.amazonaws.com/": "",                          //lintignore:AWSAT003
+			},
+		},
+		"provider http_proxy with service https_proxy": {
+			httpProxy: "http://provider.example.com:3128",
+			config: EndpointHTTPConfig{
+				HTTPSProxy: "http://service.example.com:3128",
+			},
+			expected: map[string]string{
+				"http://s3.us-west-2.amazonaws.com/":  "provider.example.com:3128", //lintignore:AWSAT003
+				"https://s3.us-west-2.amazonaws.com/": "service.example.com:3128",  //lintignore:AWSAT003
+			},
+		},
+		"environment with service http_proxy": {
+			env: map[string]string{
+				"HTTPS_PROXY": "http://env.example.com:3128",
+				"NO_PROXY":    "sqs.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			},
+			config: EndpointHTTPConfig{
+				HTTPProxy: "http://service.example.com:3128",
+			},
+			expected: map[string]string{
+				"http://s3.us-west-2.amazonaws.com/":   "service.example.com:3128", //lintignore:AWSAT003
+				"https://s3.us-west-2.amazonaws.com/":  "env.example.com:3128",     //lintignore:AWSAT003
+				"https://sqs.us-west-2.amazonaws.com/": "",                         //lintignore:AWSAT003
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			for _, k := range []string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy"} {
+				t.Setenv(k, "")
+			}
+			for k, v := range testCase.env {
+				t.Setenv(k, v)
+			}
+
+			client, err := newEndpointHTTPClient(sess, &aws_sdkv2.Config{}, testCase.httpProxy, testCase.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			transport := client.sdkv1.Transport.(*http.Transport)
+
+			for rawURL, expected := range testCase.expected {
+				req, _ := http.NewRequest(http.MethodGet, rawURL, nil)
+				u, err := transport.Proxy(req)
+				if err != nil {
+					t.Fatalf("%s: %s", rawURL, err)
+				}
+
+				var got string
+				if u != nil {
+					got = u.Host
+				}
+
+				if got != expected {
+					t.Errorf("%s: got proxy %q, expected %q", rawURL, got, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNewEndpointHTTPClient_invalidCustomCABundle(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session_sdkv1.NewSession(&aws_sdkv1.Config{
+		Region: aws_sdkv1.String("us-west-2"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(filename, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, bundle := range []string{filename, filepath.Join(t.TempDir(), "missing.pem")} {
+		if _, err := newEndpointHTTPClient(sess, &aws_sdkv2.Config{}, "", EndpointHTTPConfig{CustomCABundle: bundle}); err == nil {
+			t.Errorf("expected error for custom CA bundle %s", bundle)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+)
+
+// endpointStateSource is an AWS SDK for Go v2 configuration source that overrides
+// the FIPS and dual-stack endpoint settings of a single service's API client.
+type endpointStateSource struct {
+	useDualStackEndpoint aws_sdkv2.DualStackEndpointState
+	useFIPSEndpoint      aws_sdkv2.FIPSEndpointState
+}
+
+func (s endpointStateSource) GetUseDualStackEndpoint(context.Context) (aws_sdkv2.DualStackEndpointState, bool, error) {
+	return s.useDualStackEndpoint, s.useDualStackEndpoint != aws_sdkv2.DualStackEndpointStateUnset, nil
+}
+
+func (s endpointStateSource) GetUseFIPSEndpoint(context.Context) (aws_sdkv2.FIPSEndpointState, bool, error) {
+	return s.useFIPSEndpoint, s.useFIPSEndpoint != aws_sdkv2.FIPSEndpointStateUnset, nil
+}
+
+// endpointStateOverrides returns the AWS SDK for Go v1 session and v2 configuration to use for the specified service,
+// with any per-service FIPS or dual-stack endpoint overrides applied.
+func (client *AWSClient) endpointStateOverrides(servicePackageName string) (*session_sdkv1.Session, *aws_sdkv2.Config) {
+	useFIPSEndpoint, fipsOK := client.useFIPSEndpointOverrides[servicePackageName]
+	useDualStackEndpoint, dualStackOK := client.useDualStackEndpointOverrides[servicePackageName]
+
+	if !fipsOK && !dualStackOK {
+		return client.Session, client.awsConfig
+	}
+
+	configV1 := &aws_sdkv1.Config{}
+	var source endpointStateSource
+
+	if fipsOK {
+		if useFIPSEndpoint {
+			configV1.UseFIPSEndpoint = endpoints_sdkv1.FIPSEndpointStateEnabled
+			source.useFIPSEndpoint = aws_sdkv2.FIPSEndpointStateEnabled
+		} else {
+			configV1.UseFIPSEndpoint = endpoints_sdkv1.FIPSEndpointStateDisabled
+			source.useFIPSEndpoint = aws_sdkv2.FIPSEndpointStateDisabled
+		}
+	}
+
+	if dualStackOK {
+		if useDualStackEndpoint {
+			configV1.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateEnabled
+			source.useDualStackEndpoint = aws_sdkv2.DualStackEndpointStateEnabled
+		} else {
+			configV1.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateDisabled
+			source.useDualStackEndpoint = aws_sdkv2.DualStackEndpointStateDisabled
+		}
+	}
+
+	var sess *session_sdkv1.Session
+	if client.Session != nil {
+		sess = client.Session.Copy(configV1)
+	}
+
+	var cfg *aws_sdkv2.Config
+	if client.awsConfig != nil {
+		v := client.awsConfig.Copy()
+		// Configuration sources are consulted in order, so the override takes precedence.
+		v.ConfigSources = append([]interface{}{source}, v.ConfigSources...)
+		cfg = &v
+	}
+
+	return sess, cfg
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAWSClientEndpointStateOverrides(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+
+	sess, err := session_sdkv1.NewSession(&aws_sdkv1.Config{
+		Region:          aws_sdkv1.String("us-west-2"), //lintignore:AWSAT003
+		UseFIPSEndpoint: endpoints_sdkv1.FIPSEndpointStateEnabled,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		Session: sess,
+		awsConfig: &aws_sdkv2.Config{
+			ConfigSources: []interface{}{endpointStateSource{useFIPSEndpoint: aws_sdkv2.FIPSEndpointStateEnabled}},
+		},
+		useDualStackEndpointOverrides: map[string]bool{names.SQS: true},
+		useFIPSEndpointOverrides:      map[string]bool{names.SQS: false},
+	}
+
+	// No overrides.
+	gotSess, gotConfig := client.endpointStateOverrides(names.SNS)
+
+	if gotSess != client.Session {
+		t.Errorf("expected provider session for %s", names.SNS)
+	}
+
+	if gotConfig != client.awsConfig {
+		t.Errorf("expected provider configuration for %s", names.SNS)
+	}
+
+	// Overrides.
+	gotSess, gotConfig = client.endpointStateOverrides(names.SQS)
+
+	if got, expected := gotSess.Config.UseFIPSEndpoint, endpoints_sdkv1.FIPSEndpointStateDisabled; got != expected {
+		t.Errorf("got AWS SDK for Go v1 UseFIPSEndpoint %v, expected %v", got, expected)
+	}
+
+	if got, expected := gotSess.Config.UseDualStackEndpoint, endpoints_sdkv1.DualStackEndpointStateEnabled; got != expected {
+		t.Errorf("got AWS SDK for Go v1 UseDualStackEndpoint %v, expected %v", got, expected)
+	}
+
+	if got, expected := client.Session.Config.UseFIPSEndpoint, endpoints_sdkv1.FIPSEndpointStateEnabled; got != expected {
+		t.Errorf("provider session modified: got UseFIPSEndpoint %v, expected %v", got, expected)
+	}
+
+	if got, expected := len(gotConfig.ConfigSources), 2; got != expected {
+		t.Fatalf("got %d AWS SDK for Go v2 configuration sources, expected %d", got, expected)
+	}
+
+	if got, expected := len(client.awsConfig.ConfigSources), 1; got != expected {
+		t.Errorf("provider configuration modified: got %d configuration sources, expected %d", got, expected)
+	}
+
+	source := gotConfig.ConfigSources[0].(endpointStateSource)
+
+	if got, found, _ := source.GetUseFIPSEndpoint(ctx); !found || got != aws_sdkv2.FIPSEndpointStateDisabled {
+		t.Errorf("got AWS SDK for Go v2 UseFIPSEndpoint %v (found: %t), expected %v", got, found, aws_sdkv2.FIPSEndpointStateDisabled)
+	}
+
+	if got, found, _ := source.GetUseDualStackEndpoint(ctx); !found || got != aws_sdkv2.DualStackEndpointStateEnabled {
+		t.Errorf("got AWS SDK for Go v2 UseDualStackEndpoint %v (found: %t), expected %v", got, found, aws_sdkv2.DualStackEndpointStateEnabled)
+	}
+}
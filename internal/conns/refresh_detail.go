@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+const (
+	RefreshDetailFull    = "full"
+	RefreshDetailMinimal = "minimal"
+)
+
+// MinimalRefresh returns whether the provider is configured to skip slow enrichment calls,
+// such as reads of resource policies or per-item list calls, when refreshing resources.
+// Resources should still read all attributes immediately after create, update or import.
+func (client *AWSClient) MinimalRefresh() bool {
+	return client.refreshDetail == RefreshDetailMinimal
+}
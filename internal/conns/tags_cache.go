@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	arn_sdkv1 "github.com/aws/aws-sdk-go/aws/arn"
+	resourcegroupstaggingapi_sdkv1 "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+const (
+	// resourceTagsCacheLoadAttempts is the number of failed bulk reads after which bulk tag reads are disabled.
+	resourceTagsCacheLoadAttempts = 3
+	// resourceTagsCacheLoadTimeout bounds a single bulk read.
+	resourceTagsCacheLoadTimeout = 10 * time.Minute
+)
+
+// resourceTagsCache holds the tags of every tagged resource in the provider's Region.
+// The tags are read on first use via the Resource Groups Tagging API.
+// A failed read is retried by later callers, up to resourceTagsCacheLoadAttempts times.
+type resourceTagsCache struct {
+	mu       sync.Mutex
+	loaded   bool
+	failures int
+	tags     map[string]map[string]string // Keyed by resource ARN.
+
+	// list reads the tags of all resources. If nil, the Resource Groups Tagging API is used.
+	list func(context.Context) (map[string]map[string]string, error)
+}
+
+// CachedResourceTags returns the tags for the resource with the specified ARN from the bulk tag read cache.
+// The second return value is false if bulk tag reads are disabled or the resource is not in the cache,
+// in which case the caller should read the resource's tags from the service API.
+func (client *AWSClient) CachedResourceTags(ctx context.Context, identifier string) (map[string]string, bool) {
+	if client.tagsCache == nil {
+		return nil, false
+	}
+
+	// Only ARNs in the provider's Region can be served from the cache.
+	v, err := arn_sdkv1.Parse(identifier)
+
+	if err != nil || v.Region != client.Region {
+		return nil, false
+	}
+
+	return client.tagsCache.get(client, identifier)
+}
+
+func (c *resourceTagsCache) get(client *AWSClient, identifier string) (map[string]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded && c.failures < resourceTagsCacheLoadAttempts {
+		// The cache is shared by all resources, so don't let the context of the resource
+		// that happens to trigger the read cancel it.
+		loadCtx, cancel := context.WithTimeout(context.Background(), resourceTagsCacheLoadTimeout)
+		defer cancel()
+
+		list := c.list
+		if list == nil {
+			list = func(ctx context.Context) (map[string]map[string]string, error) {
+				return listAllResourceTags(ctx, client.ResourceGroupsTaggingAPIConn(ctx))
+			}
+		}
+
+		tags, err := list(loadCtx)
+
+		if err != nil {
+			c.failures++
+			log.Printf("[WARN] Bulk reading resource tags (attempt %d of %d), falling back to per-resource reads: %s", c.failures, resourceTagsCacheLoadAttempts, err)
+		} else {
+			log.Printf("[DEBUG] Bulk read tags for %d resources", len(tags))
+			c.loaded = true
+			c.tags = tags
+		}
+	}
+
+	tags, ok := c.tags[identifier]
+
+	return tags, ok
+}
+
+func listAllResourceTags(ctx context.Context, conn *resourcegroupstaggingapi_sdkv1.ResourceGroupsTaggingAPI) (map[string]map[string]string, error) {
+	input := &resourcegroupstaggingapi_sdkv1.GetResourcesInput{
+		ResourcesPerPage: aws_sdkv1.Int64(100),
+	}
+	output := make(map[string]map[string]string)
+
+	err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi_sdkv1.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			if v == nil || v.ResourceARN == nil {
+				continue
+			}
+
+			tags := make(map[string]string, len(v.Tags))
+			for _, tag := range v.Tags {
+				tags[aws_sdkv1.StringValue(tag.Key)] = aws_sdkv1.StringValue(tag.Value)
+			}
+
+			output[aws_sdkv1.StringValue(v.ResourceARN)] = tags
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
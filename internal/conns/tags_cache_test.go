@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAWSClientCachedResourceTags(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newCache := func() *resourceTagsCache {
+		return &resourceTagsCache{
+			loaded: true,
+			tags: map[string]map[string]string{
+				"arn:aws:sqs:us-west-2:123456789012:queue1": {"Name": "queue1"}, //lintignore:AWSAT003,AWSAT005
+				"arn:aws:sqs:us-west-2:123456789012:queue2": {},                 //lintignore:AWSAT003,AWSAT005
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name         string
+		AWSClient    *AWSClient
+		Identifier   string
+		ExpectedTags map[string]string
+		ExpectedOK   bool
+	}{
+		{
+			Name:       "disabled",
+			AWSClient:  &AWSClient{Region: "us-west-2"},             //lintignore:AWSAT003
+			Identifier: "arn:aws:sqs:us-west-2:123456789012:queue1", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:       "not an ARN",
+			AWSClient:  &AWSClient{Region: "us-west-2", tagsCache: newCache()}, //lintignore:AWSAT003
+			Identifier: "queue1",
+		},
+		{
+			Name:       "other Region",
+			AWSClient:  &AWSClient{Region: "us-east-1", tagsCache: newCache()}, //lintignore:AWSAT003
+			Identifier: "arn:aws:sqs:us-west-2:123456789012:queue1",            //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:       "miss",
+			AWSClient:  &AWSClient{Region: "us-west-2", tagsCache: newCache()}, //lintignore:AWSAT003
+			Identifier: "arn:aws:sqs:us-west-2:123456789012:queue3",            //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:         "hit",
+			AWSClient:    &AWSClient{Region: "us-west-2", tagsCache: newCache()}, //lintignore:AWSAT003
+			Identifier:   "arn:aws:sqs:us-west-2:123456789012:queue1",            //lintignore:AWSAT003,AWSAT005
+			ExpectedTags: map[string]string{"Name": "queue1"},
+			ExpectedOK:   true,
+		},
+		{
+			Name:         "hit no tags",
+			AWSClient:    &AWSClient{Region: "us-west-2", tagsCache: newCache()}, //lintignore:AWSAT003
+			Identifier:   "arn:aws:sqs:us-west-2:123456789012:queue2",            //lintignore:AWSAT003,AWSAT005
+			ExpectedTags: map[string]string{},
+			ExpectedOK:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tags, ok := testCase.AWSClient.CachedResourceTags(ctx, testCase.Identifier)
+
+			if ok != testCase.ExpectedOK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if testCase.ExpectedOK && !reflect.DeepEqual(tags, testCase.ExpectedTags) {
+				t.Errorf("got tags %v, expected %v", tags, testCase.ExpectedTags)
+			}
+		})
+	}
+}
+
+func TestAWSClientCachedResourceTags_retry(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// The resource's context being cancelled must not affect the bulk read.
+	cancel()
+
+	identifier := "arn:aws:sqs:us-west-2:123456789012:queue1" //lintignore:AWSAT003,AWSAT005
+	calls := 0
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+		tagsCache: &resourceTagsCache{
+			list: func(ctx context.Context) (map[string]map[string]string, error) {
+				calls++
+
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+
+				if calls == 1 {
+					return nil, errors.New("throttled")
+				}
+
+				return map[string]map[string]string{identifier: {"Name": "queue1"}}, nil
+			},
+		},
+	}
+
+	if _, ok := client.CachedResourceTags(ctx, identifier); ok {
+		t.Error("got ok after failed read, expected miss")
+	}
+
+	tags, ok := client.CachedResourceTags(ctx, identifier)
+
+	if !ok {
+		t.Fatal("got miss after successful retry, expected hit")
+	}
+
+	if got, want := tags, map[string]string{"Name": "queue1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got tags %v, expected %v", got, want)
+	}
+
+	client.CachedResourceTags(ctx, identifier)
+
+	if got, want := calls, 2; got != want {
+		t.Errorf("got %d bulk reads, expected %d", got, want)
+	}
+}
+
+func TestAWSClientCachedResourceTags_giveUp(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	identifier := "arn:aws:sqs:us-west-2:123456789012:queue1" //lintignore:AWSAT003,AWSAT005
+	calls := 0
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+		tagsCache: &resourceTagsCache{
+			list: func(ctx context.Context) (map[string]map[string]string, error) {
+				calls++
+
+				return nil, errors.New("access denied")
+			},
+		},
+	}
+
+	for i := 0; i < resourceTagsCacheLoadAttempts+2; i++ {
+		if _, ok := client.CachedResourceTags(ctx, identifier); ok {
+			t.Fatal("got ok, expected miss")
+		}
+	}
+
+	if got, want := calls, resourceTagsCacheLoadAttempts; got != want {
+		t.Errorf("got %d bulk reads, expected %d", got, want)
+	}
+}
@@ -391,7 +391,10 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 					// If the service package has a generic resource list tags methods, call it.
 					var err error
 
-					if v, ok := sp.(interface {
+					// Prefer tags from the provider's bulk tag read cache.
+					if tags, ok := meta.CachedResourceTags(ctx, identifier); ok {
+						tagsInContext.TagsOut = types.Some(tftags.New(ctx, tags))
+					} else if v, ok := sp.(interface {
 						ListTags(context.Context, any, string) error
 					}); ok {
 						err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
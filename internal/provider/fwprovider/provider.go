@@ -9,7 +9,9 @@ import (
 
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -47,10 +49,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The access key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The account ID of the AWS account the provider operates in. If set, the provider does not\ncall AWS APIs to validate credentials or determine the account ID and partition.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("allowed_account_ids"), path.MatchRoot("forbidden_account_ids")),
+				},
+			},
 			"allowed_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"bulk_tag_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Read resource tags in bulk via the Resource Groups Tagging API when refreshing resources,\nfalling back to per-resource calls for resources not returned by that API.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -79,10 +92,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"partition": schema.StringAttribute{
+				Optional:    true,
+				Description: "The partition of the AWS account the provider operates in. Only used with `account_id`.\nIf not set, the partition is derived from the region.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(conns.PartitionIDs()...),
+					stringvalidator.AlsoRequires(path.MatchRoot("account_id")),
+				},
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"refresh_detail": schema.StringAttribute{
+				Optional:    true,
+				Description: "The level of detail read when refreshing resources. Valid values are `full` and `minimal`.\nWith `minimal`, resources that support it skip slow enrichment calls during refresh.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(conns.RefreshDetailFull, conns.RefreshDetailMinimal),
+				},
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -229,6 +257,20 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"client_metrics": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to export AWS API client metrics.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"otlp_endpoint": schema.StringAttribute{
+							Required:    true,
+							Description: "URL of the OTLP/HTTP endpoint to export AWS API call metrics to.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -411,6 +453,37 @@ func endpointsBlock() schema.SetNestedBlock {
 		}
 	}
 
+	endpointsAttributes["custom_ca_bundle"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Use this to override the provider's custom_ca_bundle setting for individual services",
+	}
+	endpointsAttributes["http_proxy"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Use this to set the proxy for HTTP requests for individual services",
+	}
+	endpointsAttributes["https_proxy"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Use this to set the proxy for HTTPS requests for individual services",
+	}
+	endpointsAttributes["no_proxy"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Use this to set the hosts to exclude from proxying for individual services",
+	}
+	endpointsAttributes["use_dualstack_endpoint"] = schema.MapAttribute{
+		ElementType: types.BoolType,
+		Optional:    true,
+		Description: "Use this to override the provider's use_dualstack_endpoint setting for individual services",
+	}
+	endpointsAttributes["use_fips_endpoint"] = schema.MapAttribute{
+		ElementType: types.BoolType,
+		Optional:    true,
+		Description: "Use this to override the provider's use_fips_endpoint setting for individual services",
+	}
+
 	return schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
 			Attributes: endpointsAttributes,
@@ -317,7 +317,10 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 						// If the service package has a generic resource list tags methods, call it.
 						var err error
 
-						if v, ok := sp.(interface {
+						// On refresh, prefer tags from the provider's bulk tag read cache.
+						if tags, ok := cachedResourceTags(ctx, meta.(*conns.AWSClient), why, identifier); ok {
+							tagsInContext.TagsOut = types.Some(tftags.New(ctx, tags))
+						} else if v, ok := sp.(interface {
 							ListTags(context.Context, any, string) error
 						}); ok {
 							err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
@@ -371,3 +374,13 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 
 	return ctx, diags
 }
+
+// cachedResourceTags returns a resource's tags from the provider's bulk tag read cache.
+// The cache is only used when refreshing, as tags read in bulk may not yet reflect changes made during Create or Update.
+func cachedResourceTags(ctx context.Context, meta *conns.AWSClient, why why, identifier string) (map[string]string, bool) {
+	if why != Read {
+		return nil, false
+	}
+
+	return meta.CachedResourceTags(ctx, identifier)
+}
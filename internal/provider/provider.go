@@ -40,6 +40,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The access key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  verify.ValidAccountID,
+				ConflictsWith: []string{"allowed_account_ids", "forbidden_account_ids"},
+				Description: "The account ID of the AWS account the provider operates in. If set, the provider does not\n" +
+					"call AWS APIs to validate credentials or determine the account ID and partition.",
+			},
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -48,6 +56,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"bulk_tag_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Read resource tags in bulk via the Resource Groups Tagging API when refreshing resources,\n" +
+					"falling back to per-resource calls for resources not returned by that API.",
+			},
+			"client_metrics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to export AWS API client metrics.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"otlp_endpoint": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the OTLP/HTTP endpoint to export AWS API call metrics to.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -131,12 +160,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"partition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"account_id"},
+				ValidateFunc: validation.StringInSlice(conns.PartitionIDs(), false),
+				Description: "The partition of the AWS account the provider operates in. Only used with `account_id`.\n" +
+					"If not set, the partition is derived from the region.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"refresh_detail": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The level of detail read when refreshing resources. Valid values are `full` and `minimal`.\n" +
+					"With `minimal`, resources that support it skip slow enrichment calls during refresh.",
+				ValidateFunc: validation.StringInSlice([]string{conns.RefreshDetailFull, conns.RefreshDetailMinimal}, false),
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -421,6 +465,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AccountID:                      d.Get("account_id").(string),
+		BulkTagReads:                   d.Get("bulk_tag_reads").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -428,7 +474,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		HTTPProxy:                      d.Get("http_proxy").(string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		Partition:                      d.Get("partition").(string),
 		Profile:                        d.Get("profile").(string),
+		RefreshDetail:                  d.Get("refresh_detail").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -472,6 +520,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("client_metrics"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.ClientMetricsOTLPEndpoint = v.([]interface{})[0].(map[string]interface{})["otlp_endpoint"].(string)
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -484,6 +536,18 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 
 		config.Endpoints = endpoints
+
+		if config.UseDualStackEndpointOverrides, err = expandEndpointStateOverrides(ctx, v.(*schema.Set).List(), "use_dualstack_endpoint"); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if config.UseFIPSEndpointOverrides, err = expandEndpointStateOverrides(ctx, v.(*schema.Set).List(), "use_fips_endpoint"); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if config.EndpointHTTPConfigs, err = expandEndpointHTTPConfigs(ctx, v.(*schema.Set).List()); err != nil {
+			return nil, diag.FromErr(err)
+		}
 	}
 
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
@@ -669,6 +733,43 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	endpointsAttributes["custom_ca_bundle"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Use this to override the provider's custom_ca_bundle setting for individual services",
+	}
+	endpointsAttributes["http_proxy"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Use this to set the proxy for HTTP requests for individual services",
+	}
+	endpointsAttributes["https_proxy"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Use this to set the proxy for HTTPS requests for individual services",
+	}
+	endpointsAttributes["no_proxy"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Use this to set the hosts to exclude from proxying for individual services",
+	}
+	endpointsAttributes["use_dualstack_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Use this to override the provider's use_dualstack_endpoint setting for individual services",
+	}
+	endpointsAttributes["use_fips_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Use this to override the provider's use_fips_endpoint setting for individual services",
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -796,6 +897,89 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+// expandEndpointStateOverrides returns the per-service overrides of a provider-wide
+// endpoint setting, keyed by service package name.
+func expandEndpointStateOverrides(_ context.Context, tfList []interface{}, key string) (map[string]bool, error) {
+	var overrides map[string]bool
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap[key].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for alias, v := range v {
+			pkg, err := names.ProviderPackageForAlias(alias)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s override (%s): %w", key, alias, err)
+			}
+
+			if overrides == nil {
+				overrides = make(map[string]bool)
+			}
+
+			if _, ok := overrides[pkg]; !ok {
+				overrides[pkg] = v.(bool)
+			}
+		}
+	}
+
+	return overrides, nil
+}
+
+// expandEndpointHTTPConfigs returns the per-service overrides of the provider's HTTP settings,
+// keyed by service package name.
+func expandEndpointHTTPConfigs(_ context.Context, tfList []interface{}) (map[string]conns.EndpointHTTPConfig, error) {
+	var configs map[string]conns.EndpointHTTPConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for key, set := range map[string]func(*conns.EndpointHTTPConfig, string){
+			"custom_ca_bundle": func(c *conns.EndpointHTTPConfig, v string) { c.CustomCABundle = v },
+			"http_proxy":       func(c *conns.EndpointHTTPConfig, v string) { c.HTTPProxy = v },
+			"https_proxy":      func(c *conns.EndpointHTTPConfig, v string) { c.HTTPSProxy = v },
+			"no_proxy":         func(c *conns.EndpointHTTPConfig, v string) { c.NoProxy = v },
+		} {
+			v, ok := tfMap[key].(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for alias, v := range v {
+				pkg, err := names.ProviderPackageForAlias(alias)
+
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s override (%s): %w", key, alias, err)
+				}
+
+				if configs == nil {
+					configs = make(map[string]conns.EndpointHTTPConfig)
+				}
+
+				config := configs[pkg]
+				set(&config, v.(string))
+				configs[pkg] = config
+			}
+		}
+	}
+
+	return configs, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
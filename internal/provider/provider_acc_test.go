@@ -175,6 +175,54 @@ func TestAccProvider_fipsEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccProvider_endpointStateOverrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider *schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartition(t, endpoints.AwsPartitionID) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactoriesInternal(ctx, t, &provider),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_endpointStateOverrides(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointState(ctx, &provider, func(client *conns.AWSClient) *aws.Config {
+						return &client.S3Conn(ctx).Config
+					}, endpoints.FIPSEndpointStateDisabled),
+					testAccCheckEndpointState(ctx, &provider, func(client *conns.AWSClient) *aws.Config {
+						return &client.SQSConn(ctx).Config
+					}, endpoints.FIPSEndpointStateEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_accountID(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_caller_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_accountID("123456789012"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_id", "123456789012"),
+					resource.TestCheckResourceAttr(dataSourceName, "arn", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "id", "123456789012"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_id", ""),
+				),
+			},
+		},
+	})
+}
+
 type unusualEndpoint struct {
 	fieldName string
 	thing     string
@@ -856,6 +904,20 @@ func testAccCheckUnusualEndpoints(_ context.Context, p **schema.Provider, unusua
 	}
 }
 
+func testAccCheckEndpointState(_ context.Context, p **schema.Provider, config func(*conns.AWSClient) *aws.Config, expected endpoints.FIPSEndpointState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if p == nil || *p == nil || (*p).Meta() == nil || (*p).Meta().(*conns.AWSClient) == nil {
+			return fmt.Errorf("provider not initialized")
+		}
+
+		if actual := config((*p).Meta().(*conns.AWSClient)).UseFIPSEndpoint; actual != expected {
+			return fmt.Errorf("expected UseFIPSEndpoint (%d), got: %d", expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func funcHasConnFuncSignature(method reflect.Value) bool {
 	typ := method.Type()
 	if typ.NumIn() != 1 {
@@ -928,6 +990,32 @@ resource "aws_s3_bucket" "test" {
 `, endpoint, rName))
 }
 
+func testAccProviderConfig_endpointStateOverrides() string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, `
+provider "aws" {
+  use_fips_endpoint = true
+
+  endpoints {
+    use_fips_endpoint = {
+      s3 = false
+    }
+  }
+}
+`)
+}
+
+func testAccProviderConfig_accountID(accountID string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
+provider "aws" {
+  account_id = %[1]q
+}
+
+data "aws_caller_identity" "test" {}
+`, accountID))
+}
+
 func testAccProviderConfig_unusualEndpoints(unusual1, unusual2, unusual3 unusualEndpoint) string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
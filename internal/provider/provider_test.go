@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -28,6 +29,120 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderRefreshDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"full": {
+			value: "full",
+		},
+		"minimal": {
+			value: "minimal",
+		},
+		"invalid": {
+			value:       "partial",
+			expectError: true,
+		},
+		"wrong case": {
+			value:       "Minimal",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := New(context.Background())
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"refresh_detail": testCase.value,
+			}))
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("refresh_detail = %q: got error %t, want %t (%v)", testCase.value, got, want, diags)
+			}
+		})
+	}
+}
+
+func TestProviderAccountID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config      map[string]interface{}
+		expectError bool
+	}{
+		"account_id": {
+			config: map[string]interface{}{
+				"account_id": "123456789012",
+			},
+		},
+		"account_id and partition": {
+			config: map[string]interface{}{
+				"account_id": "123456789012",
+				"partition":  "aws-us-gov",
+			},
+		},
+		"invalid partition": {
+			config: map[string]interface{}{
+				"account_id": "123456789012",
+				"partition":  "aws-moon",
+			},
+			expectError: true,
+		},
+		"partition without account_id": {
+			config: map[string]interface{}{
+				"partition": "aws-us-gov",
+			},
+			expectError: true,
+		},
+		"account_id and allowed_account_ids": {
+			config: map[string]interface{}{
+				"account_id":          "123456789012",
+				"allowed_account_ids": []interface{}{"123456789012"},
+			},
+			expectError: true,
+		},
+		"account_id and forbidden_account_ids": {
+			config: map[string]interface{}{
+				"account_id":            "123456789012",
+				"forbidden_account_ids": []interface{}{"210987654321"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := New(context.Background())
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Validate(terraform.NewResourceConfigRaw(testCase.config))
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("got error %t, want %t (%v)", got, want, diags)
+			}
+		})
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -65,6 +65,10 @@ func resourceCertificate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -252,6 +256,12 @@ func resourceCertificate() *schema.Resource {
 				},
 				ConflictsWith: []string{"certificate_body", "certificate_chain", "private_key"},
 			},
+			"wait_for_renewal": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"certificate_body", "certificate_chain", "private_key", "validation_method"},
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -506,7 +516,12 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta
 			return diag.Errorf("renewing ACM Certificate (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitCertificateRenewed(ctx, conn, d.Get("arn").(string), CertificateRenewalTimeout); err != nil {
+		timeout := CertificateRenewalTimeout
+		if d.Get("wait_for_renewal").(bool) {
+			timeout = d.Timeout(schema.TimeoutUpdate)
+		}
+
+		if _, err := waitCertificateRenewed(ctx, conn, d.Get("arn").(string), timeout); err != nil {
 			return diag.Errorf("waiting for ACM Certificate (%s) renewal: %s", d.Id(), err)
 		}
 	}
@@ -894,7 +909,7 @@ func statusCertificateRenewal(ctx context.Context, conn *acm.Client, arn string)
 
 func waitCertificateRenewed(ctx context.Context, conn *acm.Client, arn string, timeout time.Duration) (*types.RenewalSummary, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.RenewalStatusPendingAutoRenewal),
+		Pending: enum.Slice(types.RenewalStatusPendingAutoRenewal, types.RenewalStatusPendingValidation),
 		Target:  enum.Slice(types.RenewalStatusSuccess),
 		Refresh: statusCertificateRenewal(ctx, conn, arn),
 		Timeout: timeout,
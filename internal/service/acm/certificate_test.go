@@ -514,6 +514,52 @@ func TestAccACMCertificate_privateCertificate_pendingRenewalGoDuration(t *testin
 	})
 }
 
+func TestAccACMCertificate_privateCertificate_pendingRenewalWaitForRenewal(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_acm_certificate.test"
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	duration := (395 * 24 * time.Hour).String()
+	var v1, v2 types.CertificateDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_privateCertificate_waitForRenewal(commonName.String(), certificateDomainName, duration),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "pending_renewal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_renewal", "true"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).ACMClient(ctx)
+
+					_, err := conn.ExportCertificate(ctx, &acm.ExportCertificateInput{
+						CertificateArn: v1.CertificateArn,
+						Passphrase:     []byte("passphrase"),
+					})
+					if err != nil {
+						t.Fatalf("exporting ACM Certificate (%s): %s", aws.ToString(v1.CertificateArn), err)
+					}
+				},
+				Config: testAccCertificateConfig_privateCertificate_waitForRenewal(commonName.String(), certificateDomainName, duration),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &v2),
+					testAccCheckCertificateRenewed(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "pending_renewal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "renewal_summary.0.renewal_status", string(types.RenewalStatusSuccess)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificate_privateCertificate_pendingRenewalRFC3339Duration(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_acm_certificate.test"
@@ -1881,6 +1927,29 @@ resource "aws_acmpca_permission" "test" {
 `, certificateDomainName, duration))
 }
 
+func testAccCertificateConfig_privateCertificate_waitForRenewal(commonName, certificateDomainName, duration string) string {
+	return acctest.ConfigCompose(testAccCertificateConfig_privateCertificateBase(commonName), fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  early_renewal_duration = %[2]q
+  wait_for_renewal       = true
+
+  depends_on = [
+    aws_acmpca_certificate_authority_certificate.test,
+    aws_acmpca_permission.test,
+  ]
+}
+
+resource "aws_acmpca_permission" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  principal                 = "acm.amazonaws.com"
+  actions                   = ["IssueCertificate", "GetCertificate", "ListPermissions"]
+}
+`, certificateDomainName, duration))
+}
+
 func testAccCertificateConfig_subjectAlternativeNames(domainName, subjectAlternativeNames string, validationMethod types.ValidationMethod) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
@@ -97,6 +97,37 @@ func TestAccAppConfigDeployment_predefinedStrategy(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigDeployment_waitForDeployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// AppConfig Deployments cannot be destroyed, but we want to ensure
+		// the Application and its dependents are removed.
+		CheckDestroy: testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_waitForDeployment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", appconfig.DeploymentStateComplete),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
+			},
+		},
+	})
+}
+
 func TestAccAppConfigDeployment_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -249,6 +280,22 @@ resource "aws_appconfig_deployment" "test"{
 `, rName, strategy))
 }
 
+func testAccDeploymentConfig_waitForDeployment(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDeploymentBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_deployment" "test"{
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  configuration_version    = aws_appconfig_hosted_configuration_version.test.version_number
+  description              = %[1]q
+  deployment_strategy_id   = "AppConfig.AllAtOnce"
+  environment_id           = aws_appconfig_environment.test.environment_id
+  wait_for_deployment      = true
+}
+`, rName))
+}
+
 func testAccDeploymentConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccDeploymentBaseConfig(rName),
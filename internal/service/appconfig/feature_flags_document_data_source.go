@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	featureFlagAttributeTypeBoolean     = "boolean"
+	featureFlagAttributeTypeNumber      = "number"
+	featureFlagAttributeTypeNumberArray = "number[]"
+	featureFlagAttributeTypeString      = "string"
+	featureFlagAttributeTypeStringArray = "string[]"
+)
+
+func featureFlagAttributeType_Values() []string {
+	return []string{
+		featureFlagAttributeTypeBoolean,
+		featureFlagAttributeTypeNumber,
+		featureFlagAttributeTypeNumberArray,
+		featureFlagAttributeTypeString,
+		featureFlagAttributeTypeStringArray,
+	}
+}
+
+var featureFlagKeyRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,63}$`)
+
+// @SDKDataSource("aws_appconfig_feature_flags_document")
+func DataSourceFeatureFlagsDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFeatureFlagsDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"flag": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enum": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"maximum": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validFeatureFlagNumber,
+									},
+									"minimum": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validFeatureFlagNumber,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(featureFlagKeyRegexp, "must start with a letter and contain only letters, numbers, hyphens and underscores (max 64 characters)"),
+									},
+									"pattern": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+									},
+									"required": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(featureFlagAttributeType_Values(), false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"deprecation_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"planned"}, false),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(featureFlagKeyRegexp, "must start with a letter and contain only letters, numbers, hyphens and underscores (max 64 characters)"),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1",
+				ValidateFunc: validation.StringInSlice([]string{"1"}, false),
+			},
+		},
+	}
+}
+
+func dataSourceFeatureFlagsDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc, err := expandFeatureFlagsDocument(d.Get("version").(string), d.Get("flag").([]interface{}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing AppConfig Feature Flags Document: %s", err)
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing AppConfig Feature Flags Document: formatting JSON: %s", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+// featureFlagsDocument is the AWS.AppConfig.FeatureFlags configuration profile format.
+// See https://docs.aws.amazon.com/appconfig/latest/userguide/appconfig-type-reference-feature-flags.html.
+type featureFlagsDocument struct {
+	Flags   map[string]*featureFlag           `json:"flags"`
+	Values  map[string]map[string]interface{} `json:"values"`
+	Version string                            `json:"version"`
+}
+
+type featureFlag struct {
+	Attributes  map[string]*featureFlagAttribute `json:"attributes,omitempty"`
+	Deprecation *featureFlagDeprecation          `json:"_deprecation,omitempty"`
+	Description string                           `json:"description,omitempty"`
+	Name        string                           `json:"name"`
+}
+
+type featureFlagAttribute struct {
+	Constraints *featureFlagAttributeConstraints `json:"constraints"`
+}
+
+type featureFlagAttributeConstraints struct {
+	Enum     []string     `json:"enum,omitempty"`
+	Maximum  *json.Number `json:"maximum,omitempty"`
+	Minimum  *json.Number `json:"minimum,omitempty"`
+	Pattern  string       `json:"pattern,omitempty"`
+	Required bool         `json:"required,omitempty"`
+	Type     string       `json:"type"`
+}
+
+type featureFlagDeprecation struct {
+	Status string `json:"status"`
+}
+
+func expandFeatureFlagsDocument(version string, tfList []interface{}) (*featureFlagsDocument, error) {
+	doc := &featureFlagsDocument{
+		Flags:   make(map[string]*featureFlag),
+		Values:  make(map[string]map[string]interface{}),
+		Version: version,
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+
+		if _, ok := doc.Flags[key]; ok {
+			return nil, fmt.Errorf("duplicate flag key (%s)", key)
+		}
+
+		flag := &featureFlag{
+			Attributes:  make(map[string]*featureFlagAttribute),
+			Description: tfMap["description"].(string),
+			Name:        key,
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			flag.Name = v
+		}
+
+		if v, ok := tfMap["deprecation_status"].(string); ok && v != "" {
+			flag.Deprecation = &featureFlagDeprecation{Status: v}
+		}
+
+		values := map[string]interface{}{
+			"enabled": tfMap["enabled"].(bool),
+		}
+
+		for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			name := tfMap["name"].(string)
+
+			// The flag's enabled state is stored alongside its attribute values.
+			if name == "enabled" {
+				return nil, fmt.Errorf("flag (%s): attribute name (%s) is reserved", key, name)
+			}
+
+			if _, ok := flag.Attributes[name]; ok {
+				return nil, fmt.Errorf("flag (%s): duplicate attribute (%s)", key, name)
+			}
+
+			attribute, value, err := expandFeatureFlagAttribute(tfMap)
+
+			if err != nil {
+				return nil, fmt.Errorf("flag (%s) attribute (%s): %w", key, name, err)
+			}
+
+			flag.Attributes[name] = attribute
+
+			if value != nil {
+				values[name] = value
+			}
+		}
+
+		doc.Flags[key] = flag
+		doc.Values[key] = values
+	}
+
+	return doc, nil
+}
+
+func expandFeatureFlagAttribute(tfMap map[string]interface{}) (*featureFlagAttribute, interface{}, error) {
+	attributeType := tfMap["type"].(string)
+	constraints := &featureFlagAttributeConstraints{
+		Required: tfMap["required"].(bool),
+		Type:     attributeType,
+	}
+
+	isString := attributeType == featureFlagAttributeTypeString || attributeType == featureFlagAttributeTypeStringArray
+	isNumber := attributeType == featureFlagAttributeTypeNumber || attributeType == featureFlagAttributeTypeNumberArray
+
+	if v, ok := tfMap["enum"].([]interface{}); ok && len(v) > 0 {
+		if !isString {
+			return nil, nil, fmt.Errorf("enum is only supported for %s and %s attributes", featureFlagAttributeTypeString, featureFlagAttributeTypeStringArray)
+		}
+
+		for _, v := range v {
+			constraints.Enum = append(constraints.Enum, v.(string))
+		}
+	}
+
+	if v, ok := tfMap["pattern"].(string); ok && v != "" {
+		if !isString {
+			return nil, nil, fmt.Errorf("pattern is only supported for %s and %s attributes", featureFlagAttributeTypeString, featureFlagAttributeTypeStringArray)
+		}
+
+		constraints.Pattern = v
+	}
+
+	for _, k := range []string{"minimum", "maximum"} {
+		v, ok := tfMap[k].(string)
+
+		if !ok || v == "" {
+			continue
+		}
+
+		if !isNumber {
+			return nil, nil, fmt.Errorf("%s is only supported for %s and %s attributes", k, featureFlagAttributeTypeNumber, featureFlagAttributeTypeNumberArray)
+		}
+
+		n := json.Number(v)
+
+		if k == "minimum" {
+			constraints.Minimum = &n
+		} else {
+			constraints.Maximum = &n
+		}
+	}
+
+	attribute := &featureFlagAttribute{
+		Constraints: constraints,
+	}
+
+	raw, ok := tfMap["value"].(string)
+
+	if !ok || raw == "" {
+		if constraints.Required {
+			return nil, nil, fmt.Errorf("value is required")
+		}
+
+		return attribute, nil, nil
+	}
+
+	value, err := expandFeatureFlagAttributeValue(constraints, raw)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("value: %w", err)
+	}
+
+	return attribute, value, nil
+}
+
+// expandFeatureFlagAttributeValue parses an attribute value according to its type and checks it against the attribute's constraints.
+// Array values are JSON-encoded.
+func expandFeatureFlagAttributeValue(constraints *featureFlagAttributeConstraints, raw string) (interface{}, error) {
+	switch constraints.Type {
+	case featureFlagAttributeTypeBoolean:
+		return strconv.ParseBool(raw)
+
+	case featureFlagAttributeTypeNumber:
+		if err := checkFeatureFlagNumber(constraints, raw); err != nil {
+			return nil, err
+		}
+
+		return json.Number(raw), nil
+
+	case featureFlagAttributeTypeNumberArray:
+		var values []json.Number
+
+		if err := json.Unmarshal([]byte(raw), &values); err != nil {
+			return nil, fmt.Errorf("must be a JSON-encoded array of numbers: %w", err)
+		}
+
+		for _, v := range values {
+			if err := checkFeatureFlagNumber(constraints, string(v)); err != nil {
+				return nil, err
+			}
+		}
+
+		return values, nil
+
+	case featureFlagAttributeTypeString:
+		if err := checkFeatureFlagString(constraints, raw); err != nil {
+			return nil, err
+		}
+
+		return raw, nil
+
+	case featureFlagAttributeTypeStringArray:
+		var values []string
+
+		if err := json.Unmarshal([]byte(raw), &values); err != nil {
+			return nil, fmt.Errorf("must be a JSON-encoded array of strings: %w", err)
+		}
+
+		for _, v := range values {
+			if err := checkFeatureFlagString(constraints, v); err != nil {
+				return nil, err
+			}
+		}
+
+		return values, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type (%s)", constraints.Type)
+}
+
+func checkFeatureFlagNumber(constraints *featureFlagAttributeConstraints, raw string) error {
+	n, err := strconv.ParseFloat(raw, 64)
+
+	if err != nil {
+		return fmt.Errorf("%q is not a number", raw)
+	}
+
+	if v := constraints.Minimum; v != nil {
+		if min, _ := v.Float64(); n < min {
+			return fmt.Errorf("%s is less than minimum %s", raw, v)
+		}
+	}
+
+	if v := constraints.Maximum; v != nil {
+		if max, _ := v.Float64(); n > max {
+			return fmt.Errorf("%s is greater than maximum %s", raw, v)
+		}
+	}
+
+	return nil
+}
+
+func checkFeatureFlagString(constraints *featureFlagAttributeConstraints, v string) error {
+	if len(constraints.Enum) > 0 {
+		found := false
+
+		for _, e := range constraints.Enum {
+			if v == e {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("%q is not one of %q", v, constraints.Enum)
+		}
+	}
+
+	if constraints.Pattern != "" {
+		if !regexp.MustCompile(constraints.Pattern).MatchString(v) {
+			return fmt.Errorf("%q does not match pattern %q", v, constraints.Pattern)
+		}
+	}
+
+	return nil
+}
+
+func validFeatureFlagNumber(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := strconv.ParseFloat(v.(string), 64); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a number: %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppConfigFeatureFlagsDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_appconfig_feature_flags_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagsDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccFeatureFlagsDocumentExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlagsDocumentDataSource_invalidValue(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFeatureFlagsDocumentDataSourceConfig_value("number", "11"),
+				ExpectError: regexp.MustCompile(`11 is greater than maximum 10`),
+			},
+			{
+				Config:      testAccFeatureFlagsDocumentDataSourceConfig_value("number", "ten"),
+				ExpectError: regexp.MustCompile(`"ten" is not a number`),
+			},
+			{
+				Config:      testAccFeatureFlagsDocumentDataSourceConfig_value("string", "blue"),
+				ExpectError: regexp.MustCompile(`minimum is only supported for number and number\[\] attributes`),
+			},
+		},
+	})
+}
+
+const testAccFeatureFlagsDocumentDataSourceConfig_basic = `
+data "aws_appconfig_feature_flags_document" "test" {
+  flag {
+    key         = "checkout"
+    name        = "New checkout"
+    description = "Enables the new checkout flow"
+    enabled     = true
+
+    attribute {
+      name     = "color"
+      type     = "string"
+      enum     = ["blue", "green"]
+      required = true
+      value    = "green"
+    }
+
+    attribute {
+      name    = "limit"
+      type    = "number"
+      minimum = 1
+      maximum = 10
+      value   = 5
+    }
+
+    attribute {
+      name  = "regions"
+      type  = "string[]"
+      value = jsonencode(["us-east-1", "us-west-2"])
+    }
+  }
+
+  flag {
+    key                = "legacy_search"
+    enabled            = false
+    deprecation_status = "planned"
+  }
+}
+`
+
+const testAccFeatureFlagsDocumentExpectedJSON = `{
+  "flags": {
+    "checkout": {
+      "attributes": {
+        "color": {
+          "constraints": {
+            "enum": [
+              "blue",
+              "green"
+            ],
+            "required": true,
+            "type": "string"
+          }
+        },
+        "limit": {
+          "constraints": {
+            "maximum": 10,
+            "minimum": 1,
+            "type": "number"
+          }
+        },
+        "regions": {
+          "constraints": {
+            "type": "string[]"
+          }
+        }
+      },
+      "description": "Enables the new checkout flow",
+      "name": "New checkout"
+    },
+    "legacy_search": {
+      "_deprecation": {
+        "status": "planned"
+      },
+      "name": "legacy_search"
+    }
+  },
+  "values": {
+    "checkout": {
+      "color": "green",
+      "enabled": true,
+      "limit": 5,
+      "regions": [
+        "us-east-1",
+        "us-west-2"
+      ]
+    },
+    "legacy_search": {
+      "enabled": false
+    }
+  },
+  "version": "1"
+}`
+
+func testAccFeatureFlagsDocumentDataSourceConfig_value(attributeType, value string) string {
+	return fmt.Sprintf(`
+data "aws_appconfig_feature_flags_document" "test" {
+  flag {
+    key     = "test"
+    enabled = true
+
+    attribute {
+      name    = "test"
+      type    = %[1]q
+      minimum = 1
+      maximum = 10
+      value   = %[2]q
+    }
+  }
+}
+`, attributeType, value)
+}
@@ -13,6 +13,57 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func FindConfigurationProfileByTwoPartKey(ctx context.Context, conn *appconfig.AppConfig, applicationID, configurationProfileID string) (*appconfig.GetConfigurationProfileOutput, error) {
+	in := &appconfig.GetConfigurationProfileInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+	}
+	out, err := conn.GetConfigurationProfileWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindDeploymentByThreePartKey(ctx context.Context, conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int) (*appconfig.GetDeploymentOutput, error) {
+	in := &appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(applicationID),
+		DeploymentNumber: aws.Int64(int64(deploymentNumber)),
+		EnvironmentId:    aws.String(environmentID),
+	}
+	out, err := conn.GetDeploymentWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
 func FindExtensionById(ctx context.Context, conn *appconfig.AppConfig, id string) (*appconfig.GetExtensionOutput, error) {
 	in := &appconfig.GetExtensionInput{ExtensionIdentifier: aws.String(id)}
 	out, err := conn.GetExtensionWithContext(ctx, in)
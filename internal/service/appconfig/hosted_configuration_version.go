@@ -5,8 +5,11 @@ package appconfig
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -20,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/mitchellh/go-homedir"
 )
 
 // @SDKResource("aws_appconfig_hosted_configuration_version")
@@ -50,10 +55,23 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[a-z0-9]{4,7}`), ""),
 			},
 			"content": {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"content", "content_file"},
+			},
+			"content_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"content", "content_file"},
+			},
+			"content_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
 			},
 			"content_type": {
 				Type:         schema.TypeString,
@@ -72,6 +90,8 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceHostedConfigurationVersionCustomizeDiff,
 	}
 }
 
@@ -82,10 +102,16 @@ func resourceHostedConfigurationVersionCreate(ctx context.Context, d *schema.Res
 	appID := d.Get("application_id").(string)
 	profileID := d.Get("configuration_profile_id").(string)
 
+	content, err := hostedConfigurationVersionContent(d.Get("content").(string), d.Get("content_file").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppConfig HostedConfigurationVersion for Application (%s): %s", appID, err)
+	}
+
 	input := &appconfig.CreateHostedConfigurationVersionInput{
 		ApplicationId:          aws.String(appID),
 		ConfigurationProfileId: aws.String(profileID),
-		Content:                []byte(d.Get("content").(string)),
+		Content:                content,
 		ContentType:            aws.String(d.Get("content_type").(string)),
 	}
 
@@ -138,7 +164,11 @@ func resourceHostedConfigurationVersionRead(ctx context.Context, d *schema.Resou
 
 	d.Set("application_id", output.ApplicationId)
 	d.Set("configuration_profile_id", output.ConfigurationProfileId)
-	d.Set("content", string(output.Content))
+	// Content read from a file is not stored in state.
+	if _, ok := d.GetOk("content_file"); !ok {
+		d.Set("content", string(output.Content))
+	}
+	d.Set("content_hash", hostedConfigurationVersionContentHash(output.Content))
 	d.Set("content_type", output.ContentType)
 	d.Set("description", output.Description)
 	d.Set("version_number", output.VersionNumber)
@@ -185,6 +215,88 @@ func resourceHostedConfigurationVersionDelete(ctx context.Context, d *schema.Res
 	return diags
 }
 
+func resourceHostedConfigurationVersionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"application_id", "configuration_profile_id", "content", "content_file", "content_type"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	content, err := hostedConfigurationVersionContent(d.Get("content").(string), d.Get("content_file").(string))
+
+	if err != nil {
+		return err
+	}
+
+	// Unless configured explicitly, the content hash is computed at plan time
+	// so that changes to the content file force a new version.
+	if d.GetRawConfig().GetAttr("content_hash").IsNull() {
+		if o, n := d.Get("content_hash").(string), hostedConfigurationVersionContentHash(content); o != n {
+			if err := d.SetNew("content_hash", n); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Id() != "" && !d.HasChanges("content", "content_file", "content_hash", "content_type", "configuration_profile_id") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AppConfigConn(ctx)
+	appID, profileID := d.Get("application_id").(string), d.Get("configuration_profile_id").(string)
+
+	profile, err := FindConfigurationProfileByTwoPartKey(ctx, conn, appID, profileID)
+
+	// The configuration profile may not have been created yet.
+	if tfresource.NotFound(err) {
+		return validateHostedConfigurationContent(d.Get("content_type").(string), string(content), nil)
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to validate AppConfig Hosted Configuration Version content against JSON schema validators: reading Configuration Profile (%s) for Application (%s): %s", profileID, appID, err)
+		return validateHostedConfigurationContent(d.Get("content_type").(string), string(content), nil)
+	}
+
+	var jsonSchemas []string
+
+	for _, v := range profile.Validators {
+		if aws.StringValue(v.Type) == appconfig.ValidatorTypeJsonSchema {
+			jsonSchemas = append(jsonSchemas, aws.StringValue(v.Content))
+		}
+	}
+
+	if err := validateHostedConfigurationContent(d.Get("content_type").(string), string(content), jsonSchemas); err != nil {
+		return fmt.Errorf("validating content for AppConfig Configuration Profile (%s): %w", profileID, err)
+	}
+
+	return nil
+}
+
+func hostedConfigurationVersionContent(content, contentFile string) ([]byte, error) {
+	if contentFile == "" {
+		return []byte(content), nil
+	}
+
+	filename, err := homedir.Expand(contentFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading content file (%s): %w", contentFile, err)
+	}
+
+	return output, nil
+}
+
+func hostedConfigurationVersionContentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
 func HostedConfigurationVersionParseID(id string) (string, string, int, error) {
 	parts := strings.Split(id, "/")
 
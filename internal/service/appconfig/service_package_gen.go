@@ -50,6 +50,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEnvironments,
 			TypeName: "aws_appconfig_environments",
 		},
+		{
+			Factory:  DataSourceFeatureFlagsDocument,
+			TypeName: "aws_appconfig_feature_flags_document",
+		},
 	}
 }
 
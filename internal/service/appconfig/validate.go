@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+)
+
+// validateHostedConfigurationContent checks that hosted configuration content is well-formed
+// for its content type and, if any JSON schemas are supplied, that it satisfies each of them.
+// Content types other than JSON and YAML are not validated.
+func validateHostedConfigurationContent(contentType, content string, jsonSchemas []string) error {
+	var v interface{}
+
+	switch mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0]); {
+	case strings.EqualFold(mediaType, "application/json"):
+		if err := json.Unmarshal([]byte(content), &v); err != nil {
+			return fmt.Errorf("parsing JSON: %w", err)
+		}
+	case strings.HasSuffix(strings.ToLower(mediaType), "yaml"):
+		if err := yaml.Unmarshal([]byte(content), &v); err != nil {
+			return fmt.Errorf("parsing YAML: %w", err)
+		}
+		v = normalizeYAMLValue(v)
+	default:
+		return nil
+	}
+
+	for _, jsonSchema := range jsonSchemas {
+		result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(jsonSchema), gojsonschema.NewGoLoader(v))
+
+		if err != nil {
+			return fmt.Errorf("validating against JSON schema: %w", err)
+		}
+
+		if result.Valid() {
+			continue
+		}
+
+		var errs []string
+
+		for _, v := range result.Errors() {
+			errs = append(errs, v.String())
+		}
+
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// normalizeYAMLValue converts the map[interface{}]interface{} values produced by the YAML parser
+// into the map[string]interface{} values expected by the JSON schema validator.
+func normalizeYAMLValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, v := range v {
+			m[fmt.Sprint(k)] = normalizeYAMLValue(v)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeYAMLValue(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
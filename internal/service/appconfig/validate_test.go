@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"testing"
+)
+
+func TestValidateHostedConfigurationContent(t *testing.T) {
+	t.Parallel()
+
+	jsonSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "required": ["enabled"],
+  "properties": {
+    "enabled": {"type": "boolean"},
+    "limit": {"type": "integer"}
+  }
+}`
+
+	cases := []struct {
+		Name        string
+		ContentType string
+		Content     string
+		JSONSchemas []string
+		ExpectError bool
+	}{
+		{
+			Name:        "valid JSON",
+			ContentType: "application/json",
+			Content:     `{"enabled": true, "limit": 10}`,
+			JSONSchemas: []string{jsonSchema},
+		},
+		{
+			Name:        "valid YAML",
+			ContentType: "application/x-yaml",
+			Content:     "enabled: true\nlimit: 10\n",
+			JSONSchemas: []string{jsonSchema},
+		},
+		{
+			Name:        "JSON without schema",
+			ContentType: "application/json; charset=utf-8",
+			Content:     `{"anything": "goes"}`,
+		},
+		{
+			Name:        "malformed JSON",
+			ContentType: "application/json",
+			Content:     `{"enabled": `,
+			ExpectError: true,
+		},
+		{
+			Name:        "malformed YAML",
+			ContentType: "text/yaml",
+			Content:     "enabled: [true\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "missing required property",
+			ContentType: "application/json",
+			Content:     `{"limit": 10}`,
+			JSONSchemas: []string{jsonSchema},
+			ExpectError: true,
+		},
+		{
+			Name:        "wrong type",
+			ContentType: "application/x-yaml",
+			Content:     "enabled: yes please\n",
+			JSONSchemas: []string{jsonSchema},
+			ExpectError: true,
+		},
+		{
+			Name:        "other content type",
+			ContentType: "text/plain",
+			Content:     `{"enabled": `,
+			JSONSchemas: []string{jsonSchema},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateHostedConfigurationContent(tc.ContentType, tc.Content, tc.JSONSchemas)
+
+			if tc.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitDeploymentComplete(ctx context.Context, conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int, timeout time.Duration) (*appconfig.GetDeploymentOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			appconfig.DeploymentStateBaking,
+			appconfig.DeploymentStateDeploying,
+			appconfig.DeploymentStateRollingBack,
+			appconfig.DeploymentStateValidating,
+		},
+		Target:     []string{appconfig.DeploymentStateComplete},
+		Refresh:    statusDeployment(ctx, conn, applicationID, environmentID, deploymentNumber),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appconfig.GetDeploymentOutput); ok {
+		if aws.StringValue(output.State) == appconfig.DeploymentStateRolledBack {
+			tfresource.SetLastError(err, deploymentRollbackError(output.EventLog))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// deploymentRollbackError returns an error describing the event that started a deployment's rollback.
+// The event log is ordered most recent first.
+func deploymentRollbackError(events []*appconfig.DeploymentEvent) error {
+	for _, event := range events {
+		if event == nil || aws.StringValue(event.EventType) != appconfig.DeploymentEventTypeRollbackStarted {
+			continue
+		}
+
+		return fmt.Errorf("rollback triggered by %s: %s", aws.StringValue(event.TriggeredBy), aws.StringValue(event.Description))
+	}
+
+	return errors.New("deployment rolled back")
+}
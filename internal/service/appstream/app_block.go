@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_app_block", name="App Block")
+// @Tags(identifierAttribute="arn")
+func ResourceAppBlock() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppBlockCreate,
+		ReadWithoutTimeout:   resourceAppBlockRead,
+		UpdateWithoutTimeout: resourceAppBlockUpdate,
+		DeleteWithoutTimeout: resourceAppBlockDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`), "must begin with an alphanumeric character and contain only alphanumeric characters, underscores (_), periods (.) and hyphens (-)"),
+				),
+			},
+			"packaging_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appstream.PackagingType_Values(), false),
+			},
+			"post_setup_script_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     scriptDetailsSchema(),
+			},
+			"setup_script_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     scriptDetailsSchema(),
+			},
+			"source_s3_location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     s3LocationSchema(),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func s3LocationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"s3_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"s3_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func scriptDetailsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"executable_parameters": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"executable_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"script_s3_location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     s3LocationSchema(),
+			},
+			"timeout_in_seconds": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAppBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &appstream.CreateAppBlockInput{
+		Name:             aws.String(name),
+		SourceS3Location: expandS3Location(d.Get("source_s3_location").([]interface{})),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("packaging_type"); ok {
+		input.PackagingType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("post_setup_script_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PostSetupScriptDetails = expandScriptDetails(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("setup_script_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SetupScriptDetails = expandScriptDetails(v.([]interface{}))
+	}
+
+	output, err := conn.CreateAppBlockWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating AppStream App Block (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AppBlock.Arn))
+
+	return resourceAppBlockRead(ctx, d, meta)
+}
+
+func resourceAppBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	appBlock, err := FindAppBlockByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream App Block (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading AppStream App Block (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", appBlock.Arn)
+	d.Set("created_time", aws.TimeValue(appBlock.CreatedTime).Format(time.RFC3339))
+	d.Set("description", appBlock.Description)
+	d.Set("display_name", appBlock.DisplayName)
+	d.Set("name", appBlock.Name)
+	d.Set("packaging_type", appBlock.PackagingType)
+	if err := d.Set("post_setup_script_details", flattenScriptDetails(appBlock.PostSetupScriptDetails)); err != nil {
+		return diag.Errorf("setting post_setup_script_details: %s", err)
+	}
+	if err := d.Set("setup_script_details", flattenScriptDetails(appBlock.SetupScriptDetails)); err != nil {
+		return diag.Errorf("setting setup_script_details: %s", err)
+	}
+	if err := d.Set("source_s3_location", flattenS3Location(appBlock.SourceS3Location)); err != nil {
+		return diag.Errorf("setting source_s3_location: %s", err)
+	}
+	d.Set("state", appBlock.State)
+
+	return nil
+}
+
+func resourceAppBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAppBlockRead(ctx, d, meta)
+}
+
+func resourceAppBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	log.Printf("[DEBUG] Deleting AppStream App Block: %s", d.Id())
+	_, err := conn.DeleteAppBlockWithContext(ctx, &appstream.DeleteAppBlockInput{
+		Name: aws.String(d.Get("name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting AppStream App Block (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3Location(tfList []interface{}) *appstream.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appstream.S3Location{
+		S3Bucket: aws.String(tfMap["s3_bucket"].(string)),
+	}
+
+	if v, ok := tfMap["s3_key"].(string); ok && v != "" {
+		apiObject.S3Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Location(apiObject *appstream.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket": aws.StringValue(apiObject.S3Bucket),
+		"s3_key":    aws.StringValue(apiObject.S3Key),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandScriptDetails(tfList []interface{}) *appstream.ScriptDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appstream.ScriptDetails{
+		ExecutablePath:   aws.String(tfMap["executable_path"].(string)),
+		ScriptS3Location: expandS3Location(tfMap["script_s3_location"].([]interface{})),
+		TimeoutInSeconds: aws.Int64(int64(tfMap["timeout_in_seconds"].(int))),
+	}
+
+	if v, ok := tfMap["executable_parameters"].(string); ok && v != "" {
+		apiObject.ExecutableParameters = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenScriptDetails(apiObject *appstream.ScriptDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"executable_parameters": aws.StringValue(apiObject.ExecutableParameters),
+		"executable_path":       aws.StringValue(apiObject.ExecutablePath),
+		"script_s3_location":    flattenS3Location(apiObject.ScriptS3Location),
+		"timeout_in_seconds":    aws.Int64Value(apiObject.TimeoutInSeconds),
+	}
+
+	return []interface{}{tfMap}
+}
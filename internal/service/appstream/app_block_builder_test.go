@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppStreamAppBlockBuilder_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder.test"
+	instanceType := "stream.standard.small"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderConfig_basic(rName, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", instanceType),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "platform", appstream.AppBlockBuilderPlatformTypeWindowsServer2019),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.AppBlockBuilderStateStopped),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlockBuilder_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder.test"
+	instanceType := "stream.standard.small"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderConfig_basic(rName, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceAppBlockBuilder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlockBuilder_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder.test"
+	instanceType := "stream.standard.small"
+	instanceTypeUpdated := "stream.standard.medium"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderConfig_complete(rName, "Description", instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enable_default_internet_access", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", instanceType),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppBlockBuilderConfig_complete(rName, "Description updated", instanceTypeUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description updated"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", instanceTypeUpdated),
+				),
+			},
+			{
+				Config: testAccAppBlockBuilderConfig_basic(rName, instanceTypeUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "iam_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppBlockBuilderExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream App Block Builder ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		_, err := tfappstream.FindAppBlockBuilderByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAppBlockBuilderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_app_block_builder" {
+				continue
+			}
+
+			_, err := tfappstream.FindAppBlockBuilderByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream App Block Builder %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppBlockBuilderConfig_basic(rName, instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_appstream_app_block_builder" "test" {
+  name          = %[1]q
+  instance_type = %[2]q
+  platform      = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, rName, instanceType))
+}
+
+func testAccAppBlockBuilderConfig_complete(rName, description, instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appstream.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_appstream_app_block_builder" "test" {
+  name                           = %[1]q
+  description                    = %[2]q
+  display_name                   = %[1]q
+  enable_default_internet_access = false
+  iam_role_arn                   = aws_iam_role.test.arn
+  instance_type                  = %[3]q
+  platform                       = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.test[*].id
+  }
+}
+`, rName, description, instanceType))
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppStreamAppBlock_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appstream", regexp.MustCompile(`app-block/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "packaging_type", appstream.PackagingTypeCustom),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.executable_path", "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"),
+					resource.TestCheckResourceAttr(resourceName, "setup_script_details.0.timeout_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_location.0.s3_bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.AppBlockStateActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlock_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceAppBlock(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlock_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppBlockConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAppBlockConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppBlockExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream App Block ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		_, err := tfappstream.FindAppBlockByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAppBlockDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_app_block" {
+				continue
+			}
+
+			_, err := tfappstream.FindAppBlockByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream App Block %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppBlockConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "source.vhdx"
+  content = "test"
+}
+
+resource "aws_s3_object" "setup" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "setup.ps1"
+  content = "Write-Host 'setup'"
+}
+`, rName)
+}
+
+func testAccAppBlockConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_app_block" "test" {
+  name           = %[1]q
+  packaging_type = "CUSTOM"
+
+  source_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.source.key
+  }
+
+  setup_script_details {
+    executable_path    = "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"
+    timeout_in_seconds = 60
+
+    script_s3_location {
+      s3_bucket = aws_s3_bucket.test.id
+      s3_key    = aws_s3_object.setup.key
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAppBlockConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_app_block" "test" {
+  name           = %[1]q
+  packaging_type = "CUSTOM"
+
+  source_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.source.key
+  }
+
+  setup_script_details {
+    executable_path    = "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"
+    timeout_in_seconds = 60
+
+    script_s3_location {
+      s3_bucket = aws_s3_bucket.test.id
+      s3_key    = aws_s3_object.setup.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAppBlockConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAppBlockConfig_base(rName), fmt.Sprintf(`
+resource "aws_appstream_app_block" "test" {
+  name           = %[1]q
+  packaging_type = "CUSTOM"
+
+  source_s3_location {
+    s3_bucket = aws_s3_bucket.test.id
+    s3_key    = aws_s3_object.source.key
+  }
+
+  setup_script_details {
+    executable_path    = "C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe"
+    timeout_in_seconds = 60
+
+    script_s3_location {
+      s3_bucket = aws_s3_bucket.test.id
+      s3_key    = aws_s3_object.setup.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -195,3 +195,143 @@ func FindFleetStackAssociation(ctx context.Context, conn *appstream.AppStream, f
 
 	return nil
 }
+
+func FindAppBlockByARN(ctx context.Context, conn *appstream.AppStream, arn string) (*appstream.AppBlock, error) {
+	input := &appstream.DescribeAppBlocksInput{
+		Arns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := findAppBlock(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.Arn) != arn {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAppBlocks(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeAppBlocksInput) ([]*appstream.AppBlock, error) {
+	var output []*appstream.AppBlock
+
+	err := describeAppBlocksPages(ctx, conn, input, func(page *appstream.DescribeAppBlocksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlocks {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAppBlock(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeAppBlocksInput) (*appstream.AppBlock, error) {
+	output, err := findAppBlocks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindAppBlockBuilderByName(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.AppBlockBuilder, error) {
+	input := &appstream.DescribeAppBlockBuildersInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := findAppBlockBuilder(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.Name) != name {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilders(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeAppBlockBuildersInput) ([]*appstream.AppBlockBuilder, error) {
+	var output []*appstream.AppBlockBuilder
+
+	err := describeAppBlockBuildersPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuildersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlockBuilders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilder(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeAppBlockBuildersInput) (*appstream.AppBlockBuilder, error) {
+	output, err := findAppBlockBuilders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
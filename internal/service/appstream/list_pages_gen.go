@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeAppBlockBuilders,DescribeAppBlocks,DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks"; DO NOT EDIT.
 
 package appstream
 
@@ -10,6 +10,38 @@ import (
 	"github.com/aws/aws-sdk-go/service/appstream/appstreamiface"
 )
 
+func describeAppBlockBuildersPages(ctx context.Context, conn appstreamiface.AppStreamAPI, input *appstream.DescribeAppBlockBuildersInput, fn func(*appstream.DescribeAppBlockBuildersOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlockBuildersWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeAppBlocksPages(ctx context.Context, conn appstreamiface.AppStreamAPI, input *appstream.DescribeAppBlocksInput, fn func(*appstream.DescribeAppBlocksOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlocksWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeDirectoryConfigsPages(ctx context.Context, conn appstreamiface.AppStreamAPI, input *appstream.DescribeDirectoryConfigsInput, fn func(*appstream.DescribeDirectoryConfigsOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeDirectoryConfigsWithContext(ctx, input)
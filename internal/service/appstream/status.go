@@ -45,6 +45,22 @@ func statusImageBuilderState(ctx context.Context, conn *appstream.AppStream, nam
 	}
 }
 
+func statusAppBlockBuilderState(ctx context.Context, conn *appstream.AppStream, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAppBlockBuilderByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 // statusUserAvailable fetches the user available
 func statusUserAvailable(ctx context.Context, conn *appstream.AppStream, username, authType string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -17,6 +17,16 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_appstream_app_block", &resource.Sweeper{
+		Name: "aws_appstream_app_block",
+		F:    sweepAppBlocks,
+	})
+
+	resource.AddTestSweepers("aws_appstream_app_block_builder", &resource.Sweeper{
+		Name: "aws_appstream_app_block_builder",
+		F:    sweepAppBlockBuilders,
+	})
+
 	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
@@ -38,6 +48,96 @@ func init() {
 	})
 }
 
+func sweepAppBlocks(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.AppStreamConn(ctx)
+	input := &appstream.DescribeAppBlocksInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = describeAppBlocksPages(ctx, conn, input, func(page *appstream.DescribeAppBlocksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlocks {
+			r := ResourceAppBlock()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+			d.Set("name", v.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream App Block sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AppStream App Blocks (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping AppStream App Blocks (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAppBlockBuilders(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.AppStreamConn(ctx)
+	input := &appstream.DescribeAppBlockBuildersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = describeAppBlockBuildersPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuildersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppBlockBuilders {
+			r := ResourceAppBlockBuilder()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+			d.Set("state", v.State)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream App Block Builder sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AppStream App Block Builders (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping AppStream App Block Builders (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepDirectoryConfigs(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -23,6 +23,9 @@ const (
 	// imageBuilderStateTimeout Maximum amount of time to wait for the statusImageBuilderState to be RUNNING
 	// or for the ImageBuilder to be deleted
 	imageBuilderStateTimeout = 60 * time.Minute
+	// appBlockBuilderStateTimeout Maximum amount of time to wait for the statusAppBlockBuilderState to be STOPPED
+	// or for the AppBlockBuilder to be deleted
+	appBlockBuilderStateTimeout = 60 * time.Minute
 	// userOperationTimeout Maximum amount of time to wait for User operation eventual consistency
 	userOperationTimeout = 4 * time.Minute
 	// iamPropagationTimeout Maximum amount of time to wait for an iam resource eventual consistency
@@ -140,6 +143,50 @@ func waitImageBuilderStateDeleted(ctx context.Context, conn *appstream.AppStream
 	return nil, err
 }
 
+func waitAppBlockBuilderStateStopped(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.AppBlockBuilder, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appstream.AppBlockBuilderStateStarting, appstream.AppBlockBuilderStateRunning, appstream.AppBlockBuilderStateStopping},
+		Target:  []string{appstream.AppBlockBuilderStateStopped},
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.AppBlockBuilder); ok {
+		if errors := output.AppBlockBuilderErrors; len(errors) > 0 {
+			var errs *multierror.Error
+
+			for _, err := range errors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(err.ErrorCode), aws.StringValue(err.ErrorMessage)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderDeleted(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.AppBlockBuilder, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appstream.AppBlockBuilderStateStopped},
+		Target:  []string{},
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.AppBlockBuilder); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // waitUserAvailable waits for a user be available
 func waitUserAvailable(ctx context.Context, conn *appstream.AppStream, username, authType string) (*appstream.User, error) {
 	stateConf := &retry.StateChangeConf{
@@ -5,6 +5,7 @@ package autoscaling
 
 import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -215,6 +216,11 @@ func ResourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -1476,9 +1482,17 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				mixedInstancesPolicy = expandMixedInstancesPolicy(v.([]interface{})[0].(map[string]interface{}))
 			}
 
-			if err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy)); err != nil {
+			instanceRefreshID, err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy))
+
+			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if tfMap["wait_for_completion"].(bool) {
+				if _, err := waitInstanceRefreshCompleted(ctx, conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s) complete: %s", d.Id(), instanceRefreshID, err)
+				}
+			}
 		}
 	}
 
@@ -2578,6 +2592,44 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoSca
 	return nil, err
 }
 
+func waitInstanceRefreshCompleted(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	refresh := statusInstanceRefresh(ctx, conn, name, id)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusCancelling,
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusRollbackInProgress,
+		},
+		Target: []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			// Surface progress, including time spent waiting at checkpoints, in the provider log.
+			if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+				log.Printf("[INFO] Auto Scaling Group (%s) instance refresh (%s) %s: %d%% complete, %d instances to update, status reason: %s",
+					name, id, status, aws.Int64Value(output.PercentageComplete), aws.Int64Value(output.InstancesToUpdate), aws.StringValue(output.StatusReason))
+			}
+
+			return outputRaw, status, err
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		if v := aws.StringValue(output.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.AutoScaling, name string, timeout time.Duration) (*autoscaling.WarmPoolConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{autoscaling.WarmPoolStatusPendingDelete},
@@ -3844,10 +3896,10 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 	return nil
 }
 
-func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) error {
+func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) (string, error) {
 	name := aws.StringValue(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefreshWithContext(ctx, input)
 		},
@@ -3864,10 +3916,10 @@ func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, in
 		})
 
 	if err != nil {
-		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+		return "", fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	return nil
+	return aws.StringValue(outputRaw.(*autoscaling.StartInstanceRefreshOutput).InstanceRefreshId), nil
 }
 
 func validateGroupInstanceRefreshTriggerFields(i interface{}, path cty.Path) diag.Diagnostics {
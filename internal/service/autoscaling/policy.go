@@ -431,7 +431,7 @@ func customizedMetricDataQuerySchema() *schema.Schema {
 				"expression": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: validation.StringLenBetween(1, 1023),
+					ValidateFunc: validation.StringLenBetween(1, 2047),
 				},
 				"id": {
 					Type:         schema.TypeString,
@@ -6,6 +6,7 @@ package autoscaling_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -267,6 +268,40 @@ func TestAccAutoScalingPolicy_predictiveScalingCustom(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingPolicy_predictiveScalingCustomMetricMath(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v autoscaling.ScalingPolicy
+	resourceName := "aws_autoscaling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// Metric math expressions longer than 1023 characters are accepted by the API.
+	expression := "TIME_SERIES(100)" + strings.Repeat(" + TIME_SERIES(0)", 70)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_predictiveScalingCustomMetricMath(rName, expression),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_queries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_queries.0.expression", "TIME_SERIES(2)"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_queries.0.expression", expression),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_configuration.0.metric_specification.0.customized_scaling_metric_specification.0.metric_data_queries.0.expression", "TIME_SERIES(1)"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingPolicy_predictiveScalingRemoved(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v autoscaling.ScalingPolicy
@@ -790,6 +825,40 @@ resource "aws_autoscaling_policy" "test" {
 `, rName))
 }
 
+func testAccPolicyConfig_predictiveScalingCustomMetricMath(rName, loadExpression string) string {
+	return acctest.ConfigCompose(testAccPolicyConfigBase(rName), fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%[1]s-predictive"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+  predictive_scaling_configuration {
+    metric_specification {
+      target_value = 32
+      customized_capacity_metric_specification {
+        metric_data_queries {
+          id         = "capacity"
+          expression = "TIME_SERIES(2)"
+        }
+      }
+      customized_load_metric_specification {
+        metric_data_queries {
+          id         = "load_metric"
+          expression = %[2]q
+        }
+      }
+      customized_scaling_metric_specification {
+        metric_data_queries {
+          id         = "scaling_metric"
+          expression = "TIME_SERIES(1)"
+        }
+      }
+    }
+    mode = "ForecastOnly"
+  }
+}
+`, rName, loadExpression))
+}
+
 func testAccPolicyConfig_predictiveScalingRemoved(rName string) string {
 	return acctest.ConfigCompose(testAccPolicyConfigBase(rName), fmt.Sprintf(`
 resource "aws_autoscaling_policy" "test" {
@@ -77,6 +77,15 @@ func ResourceEnvironmentEC2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"managed_credentials_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloud9.ManagedCredentialsAction_Values(), false),
+			},
+			"managed_credentials_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -169,6 +178,20 @@ func resourceEnvironmentEC2Create(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "waiting for Cloud9 EC2 Environment (%s) create: %s", d.Id(), err)
 	}
 
+	// Managed temporary credentials can only be toggled after creation.
+	if v, ok := d.GetOk("managed_credentials_action"); ok {
+		input := &cloud9.UpdateEnvironmentInput{
+			EnvironmentId:            aws.String(d.Id()),
+			ManagedCredentialsAction: aws.String(v.(string)),
+		}
+
+		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cloud9 EC2 Environment (%s) managed credentials: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceEnvironmentEC2Read(ctx, d, meta)...)
 }
 
@@ -192,6 +215,7 @@ func resourceEnvironmentEC2Read(ctx context.Context, d *schema.ResourceData, met
 	d.Set("arn", arn)
 	d.Set("connection_type", env.ConnectionType)
 	d.Set("description", env.Description)
+	d.Set("managed_credentials_status", env.ManagedCredentialsStatus)
 	d.Set("name", env.Name)
 	d.Set("owner_arn", env.OwnerArn)
 	d.Set("type", env.Type)
@@ -210,6 +234,12 @@ func resourceEnvironmentEC2Update(ctx context.Context, d *schema.ResourceData, m
 			Name:          aws.String(d.Get("name").(string)),
 		}
 
+		if d.HasChange("managed_credentials_action") {
+			if v, ok := d.GetOk("managed_credentials_action"); ok {
+				input.ManagedCredentialsAction = aws.String(v.(string))
+			}
+		}
+
 		log.Printf("[INFO] Updating Cloud9 EC2 Environment: %s", input)
 		_, err := conn.UpdateEnvironmentWithContext(ctx, &input)
 
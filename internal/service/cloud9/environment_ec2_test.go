@@ -104,6 +104,45 @@ func TestAccCloud9EnvironmentEC2_allFields(t *testing.T) {
 	})
 }
 
+func TestAccCloud9EnvironmentEC2_managedCredentials(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloud9.Environment
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloud9_environment_ec2.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloud9.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloud9.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentEC2Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentEC2Config_managedCredentials(rName, cloud9.ManagedCredentialsActionDisable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentEC2Exists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "managed_credentials_action", cloud9.ManagedCredentialsActionDisable),
+					resource.TestCheckResourceAttr(resourceName, "managed_credentials_status", cloud9.ManagedCredentialsStatusDisabledByOwner),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_type", "managed_credentials_action", "subnet_id"},
+			},
+			{
+				Config: testAccEnvironmentEC2Config_managedCredentials(rName, cloud9.ManagedCredentialsActionEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentEC2Exists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "managed_credentials_action", cloud9.ManagedCredentialsActionEnable),
+					resource.TestCheckResourceAttr(resourceName, "managed_credentials_status", cloud9.ManagedCredentialsStatusEnabledByOwner),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloud9EnvironmentEC2_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf cloud9.Environment
@@ -293,6 +332,17 @@ resource "aws_iam_user" "test" {
 `, name, description, rName))
 }
 
+func testAccEnvironmentEC2Config_managedCredentials(rName, action string) string {
+	return acctest.ConfigCompose(testAccEnvironmentEC2BaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  instance_type              = "t2.micro"
+  managed_credentials_action = %[2]q
+  name                       = %[1]q
+  subnet_id                  = aws_subnet.test.id
+}
+`, rName, action))
+}
+
 func testAccEnvironmentEC2Config_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccEnvironmentEC2BaseConfig(rName), fmt.Sprintf(`
 resource "aws_cloud9_environment_ec2" "test" {
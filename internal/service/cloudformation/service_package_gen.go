@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceStack,
 			TypeName: "aws_cloudformation_stack",
 		},
+		{
+			Factory:  DataSourceStackSetDrift,
+			TypeName: "aws_cloudformation_stack_set_drift",
+		},
 		{
 			Factory:  DataSourceType,
 			TypeName: "aws_cloudformation_type",
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudformation_stack_set_drift")
+func DataSourceStackSetDrift() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStackSetDriftRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"call_as": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudformation.CallAsSelf,
+				ValidateFunc: validation.StringInSlice(cloudformation.CallAs_Values(), false),
+			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"drift_detection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failed_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"in_progress_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"in_sync_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_drift_check_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStackSetDriftRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
+
+	name := d.Get("name").(string)
+	callAs := d.Get("call_as").(string)
+
+	if d.Get("detect_drift").(bool) {
+		input := &cloudformation.DetectStackSetDriftInput{
+			CallAs:       aws.String(callAs),
+			OperationId:  aws.String(id.UniqueId()),
+			StackSetName: aws.String(name),
+		}
+
+		output, err := conn.DetectStackSetDriftWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detecting CloudFormation StackSet (%s) drift: %s", name, err)
+		}
+
+		operationID := aws.StringValue(output.OperationId)
+
+		if _, err := WaitStackSetOperationSucceeded(ctx, conn, name, operationID, callAs, d.Timeout(schema.TimeoutRead)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation StackSet (%s) drift detection (%s): %s", name, operationID, err)
+		}
+
+		d.Set("operation_id", operationID)
+	} else {
+		d.Set("operation_id", nil)
+	}
+
+	stackSet, err := FindStackSetByName(ctx, conn, name, callAs)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(stackSet.StackSetName))
+
+	if v := stackSet.StackSetDriftDetectionDetails; v != nil {
+		d.Set("drift_detection_status", v.DriftDetectionStatus)
+		d.Set("drift_status", v.DriftStatus)
+		d.Set("drifted_stack_instances_count", v.DriftedStackInstancesCount)
+		d.Set("failed_stack_instances_count", v.FailedStackInstancesCount)
+		d.Set("in_progress_stack_instances_count", v.InProgressStackInstancesCount)
+		d.Set("in_sync_stack_instances_count", v.InSyncStackInstancesCount)
+		if v.LastDriftCheckTimestamp != nil {
+			d.Set("last_drift_check_timestamp", aws.TimeValue(v.LastDriftCheckTimestamp).Format(time.RFC3339))
+		} else {
+			d.Set("last_drift_check_timestamp", nil)
+		}
+		d.Set("total_stack_instances_count", v.TotalStackInstancesCount)
+	}
+
+	return diags
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFormationStackSetDriftDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_set_drift.test"
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetDriftDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift_detection_status", cloudformation.StackSetDriftDetectionStatusCompleted),
+					resource.TestCheckResourceAttrSet(dataSourceName, "drift_status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_drift_check_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "operation_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "total_stack_instances_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStackSetDriftDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetConfig_name(rName), `
+data "aws_cloudformation_stack_set_drift" "test" {
+  name         = aws_cloudformation_stack_set.test.name
+  detect_drift = true
+}
+`)
+}
@@ -109,7 +109,7 @@ func ResourceGatewayAssociation() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
-			Update: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 	}
@@ -213,30 +213,38 @@ func resourceGatewayAssociationUpdate(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
 
 	associationID := d.Get("dx_gateway_association_id").(string)
-	input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
-		AssociationId: aws.String(associationID),
-	}
 
-	oraw, nraw := d.GetChange("allowed_prefixes")
-	o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+	if d.HasChange("allowed_prefixes") {
+		// A previous prefix update may still be in progress.
+		if _, err := waitGatewayAssociationUpdated(ctx, conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+		}
 
-	if add := n.Difference(o); add.Len() > 0 {
-		input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
-	}
+		input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AssociationId: aws.String(associationID),
+		}
 
-	if del := o.Difference(n); del.Len() > 0 {
-		input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
-	}
+		oraw, nraw := d.GetChange("allowed_prefixes")
+		o, n := oraw.(*schema.Set), nraw.(*schema.Set)
 
-	log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
-	_, err := conn.UpdateDirectConnectGatewayAssociationWithContext(ctx, input)
+		if add := n.Difference(o); add.Len() > 0 {
+			input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
-	}
+		if del := o.Difference(n); del.Len() > 0 {
+			input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
+		}
 
-	if _, err := waitGatewayAssociationUpdated(ctx, conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+		log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
+		_, err := conn.UpdateDirectConnectGatewayAssociationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitGatewayAssociationUpdated(ctx, conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceGatewayAssociationRead(ctx, d, meta)...)
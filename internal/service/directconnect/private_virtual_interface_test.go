@@ -300,6 +300,50 @@ func TestAccDirectConnectPrivateVirtualInterface_siteLink(t *testing.T) {
 	})
 }
 
+// Regression test: modifying MTU and SiteLink together must be done in a single call,
+// as the virtual interface transitions to "pending" after each modification.
+func TestAccDirectConnectPrivateVirtualInterface_mtuAndSiteLink(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var vif directconnect.VirtualInterface
+	resourceName := "aws_dx_private_virtual_interface.test"
+	rName := fmt.Sprintf("tf-testacc-private-vif-%s", sdkacctest.RandString(9))
+	amzAsn := sdkacctest.RandIntRange(64512, 65534)
+	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	vlan := sdkacctest.RandIntRange(2049, 4094)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivateVirtualInterfaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateVirtualInterfaceConfig_mtuSiteLink(connectionId, rName, amzAsn, bgpAsn, vlan, 1500, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateVirtualInterfaceExists(ctx, resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "mtu", "1500"),
+					resource.TestCheckResourceAttr(resourceName, "sitelink_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccPrivateVirtualInterfaceConfig_mtuSiteLink(connectionId, rName, amzAsn, bgpAsn, vlan, 9001, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateVirtualInterfaceExists(ctx, resourceName, &vif),
+					resource.TestCheckResourceAttr(resourceName, "jumbo_frame_capable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mtu", "9001"),
+					resource.TestCheckResourceAttr(resourceName, "sitelink_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPrivateVirtualInterfaceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		return testAccCheckVirtualInterfaceDestroy(ctx, s, "aws_dx_private_virtual_interface")
@@ -439,3 +483,23 @@ resource "aws_dx_private_virtual_interface" "test" {
 }
 `, cid, rName, amzAsn, bgpAsn, vlan, sitelink_enabled)
 }
+
+func testAccPrivateVirtualInterfaceConfig_mtuSiteLink(cid, rName string, amzAsn, bgpAsn, vlan, mtu int, sitelinkEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  amazon_side_asn = %[3]d
+  name            = %[2]q
+}
+
+resource "aws_dx_private_virtual_interface" "test" {
+  address_family   = "ipv4"
+  bgp_asn          = %[4]d
+  dx_gateway_id    = aws_dx_gateway.test.id
+  connection_id    = %[1]q
+  name             = %[2]q
+  vlan             = %[5]d
+  mtu              = %[6]d
+  sitelink_enabled = %[7]t
+}
+`, cid, rName, amzAsn, bgpAsn, vlan, mtu, sitelinkEnabled)
+}
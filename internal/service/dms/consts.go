@@ -17,6 +17,16 @@ const (
 	replicationTaskStatusStarting  = "starting"
 )
 
+const (
+	replicationAssessmentRunStatusCancelling   = "cancelling"
+	replicationAssessmentRunStatusDeleting     = "deleting"
+	replicationAssessmentRunStatusPassed       = "passed"
+	replicationAssessmentRunStatusProvisioning = "provisioning"
+	replicationAssessmentRunStatusRunning      = "running"
+	replicationAssessmentRunStatusStarting     = "starting"
+	replicationAssessmentRunStatusWarning      = "warning"
+)
+
 const (
 	engineNameAurora                     = "aurora"
 	engineNameAuroraPostgresql           = "aurora-postgresql"
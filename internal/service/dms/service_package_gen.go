@@ -74,6 +74,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceReplicationAssessmentRun,
+			TypeName: "aws_dms_replication_assessment_run",
+			Name:     "Replication Assessment Run",
+		},
 		{
 			Factory:  ResourceReplicationInstance,
 			TypeName: "aws_dms_replication_instance",
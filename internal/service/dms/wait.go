@@ -5,10 +5,13 @@ package dms
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -108,3 +111,48 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.DatabaseMigration
 
 	return err
 }
+
+func waitReplicationAssessmentRunCompleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationAssessmentRunStatusProvisioning,
+			replicationAssessmentRunStatusStarting,
+			replicationAssessmentRunStatusRunning,
+		},
+		Target: []string{
+			replicationAssessmentRunStatusPassed,
+			replicationAssessmentRunStatusWarning,
+		},
+		Refresh:    statusReplicationAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.LastFailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationAssessmentRunDeleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationAssessmentRunStatusCancelling,
+			replicationAssessmentRunStatusDeleting,
+		},
+		Target:     []string{},
+		Refresh:    statusReplicationAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_directory_service_directory_setting")
+func ResourceDirectorySetting() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySettingCreate,
+		ReadWithoutTimeout:   resourceDirectorySettingRead,
+		UpdateWithoutTimeout: resourceDirectorySettingUpdate,
+		DeleteWithoutTimeout: resourceDirectorySettingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_values": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceDirectorySettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	name := d.Get("name").(string)
+	id := DirectorySettingCreateResourceID(directoryID, name)
+
+	if err := updateDirectorySetting(ctx, conn, directoryID, name, d.Get("value").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("creating Directory Service Directory Setting (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDirectorySettingRead(ctx, d, meta)
+}
+
+func resourceDirectorySettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID, name, err := DirectorySettingParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setting, err := FindDirectorySetting(ctx, conn, directoryID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory Setting (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Directory Service Directory Setting (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allowed_values", setting.AllowedValues)
+	d.Set("directory_id", directoryID)
+	d.Set("name", setting.Name)
+	d.Set("type", setting.Type)
+	d.Set("value", setting.AppliedValue)
+
+	return nil
+}
+
+func resourceDirectorySettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID, name, err := DirectorySettingParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateDirectorySetting(ctx, conn, directoryID, name, d.Get("value").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("updating Directory Service Directory Setting (%s): %s", d.Id(), err)
+	}
+
+	return resourceDirectorySettingRead(ctx, d, meta)
+}
+
+func resourceDirectorySettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Directory Service Directory Setting (%s) cannot be removed or reset to its default value, only removing from state", d.Id())
+
+	return nil
+}
+
+func updateDirectorySetting(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, name, value string, timeout time.Duration) error {
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings: []*directoryservice.Setting{{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		}},
+	}
+
+	_, err := conn.UpdateSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitDirectorySettingUpdated(ctx, conn, directoryID, name, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+const directorySettingIDSeparator = "," // nosemgrep:ci.ds-in-const-name,ci.ds-in-var-name
+
+func DirectorySettingCreateResourceID(directoryID, name string) string {
+	parts := []string{directoryID, name}
+	id := strings.Join(parts, directorySettingIDSeparator)
+
+	return id
+}
+
+func DirectorySettingParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, directorySettingIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DirectoryID%[2]sSettingName", id, directorySettingIDSeparator)
+}
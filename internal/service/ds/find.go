@@ -141,6 +141,46 @@ func FindRadiusSettings(ctx context.Context, conn *directoryservice.DirectorySer
 	return output.RadiusSettings, nil
 }
 
+func FindDirectorySetting(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, name string) (*directoryservice.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output *directoryservice.SettingEntry
+
+	err := describeSettingsPages(ctx, conn, input, func(page *directoryservice.DescribeSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SettingEntries {
+			if v != nil && aws.StringValue(v.Name) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindRegion(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, regionName string) (*directoryservice.RegionDescription, error) {
 	input := &directoryservice.DescribeRegionsInput{
 		DirectoryId: aws.String(directoryID),
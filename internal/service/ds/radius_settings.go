@@ -5,6 +5,7 @@ package ds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -36,6 +37,8 @@ func ResourceRadiusSettings() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceRadiusSettingsCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"authentication_protocol": {
 				Type:         schema.TypeString,
@@ -89,6 +92,28 @@ func ResourceRadiusSettings() *schema.Resource {
 	}
 }
 
+func resourceRadiusSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// RADIUS is only supported by AWS Managed Microsoft AD and AD Connector directories.
+	if !d.HasChange("directory_id") || !d.NewValueKnown("directory_id") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+	directoryID := d.Get("directory_id").(string)
+
+	dir, err := FindDirectoryByID(ctx, conn, directoryID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Directory Service Directory (%s): %w", directoryID, err)
+	}
+
+	return validRadiusDirectoryType(aws.StringValue(dir.Type))
+}
+
 func resourceRadiusSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn(ctx)
 
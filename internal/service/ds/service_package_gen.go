@@ -52,6 +52,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceDirectorySetting,
+			TypeName: "aws_directory_service_directory_setting",
+		},
 		{
 			Factory:  ResourceLogSubscription,
 			TypeName: "aws_directory_service_log_subscription",
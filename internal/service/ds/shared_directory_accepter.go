@@ -103,6 +103,12 @@ func resourceSharedDirectoryAccepterRead(ctx context.Context, d *schema.Resource
 
 	dir, err := FindDirectoryByID(ctx, conn, d.Id())
 
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.DS, create.ErrActionReading, ResNameSharedDirectoryAccepter, d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
 		return create.DiagError(names.DS, create.ErrActionReading, ResNameSharedDirectoryAccepter, d.Id(), err)
 	}
@@ -76,6 +76,22 @@ func statusRadius(ctx context.Context, conn *directoryservice.DirectoryService,
 	}
 }
 
+func statusDirectorySetting(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDirectorySetting(ctx, conn, directoryID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RequestStatus), nil
+	}
+}
+
 func statusRegion(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, regionName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindRegion(ctx, conn, directoryID, regionName)
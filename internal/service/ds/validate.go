@@ -4,8 +4,10 @@
 package ds
 
 import (
+	"fmt"
 	"regexp"
 
+	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,3 +27,13 @@ var domainValidator = validation.StringMatch(domain, "must be a fully qualified
 var domainWithTrailingDotValidator validator.String = stringvalidator.RegexMatches(domainWithTrailingDot, "must be a fully qualified domain name and may end with a trailing period")
 
 var trustPasswordValidator validator.String = stringvalidator.RegexMatches(regexp.MustCompile(`^(\p{L}|\p{Nd}|\p{P}| )+$`), "can contain upper- and lower-case letters, numbers, and punctuation characters")
+
+// validRadiusDirectoryType returns an error if RADIUS cannot be enabled on directories of the specified type.
+func validRadiusDirectoryType(directoryType string) error {
+	switch directoryType {
+	case directoryservice.DirectoryTypeMicrosoftAd, directoryservice.DirectoryTypeAdconnector:
+		return nil
+	default:
+		return fmt.Errorf("RADIUS is not supported for %s directories, only %s and %s", directoryType, directoryservice.DirectoryTypeMicrosoftAd, directoryservice.DirectoryTypeAdconnector)
+	}
+}
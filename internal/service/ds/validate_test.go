@@ -179,3 +179,44 @@ func TestTrustPasswordValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestValidRadiusDirectoryType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		directoryType string
+		expectError   bool
+	}{
+		"MicrosoftAD": {
+			directoryType: "MicrosoftAD",
+		},
+		"ADConnector": {
+			directoryType: "ADConnector",
+		},
+		"SimpleAD": {
+			directoryType: "SimpleAD",
+			expectError:   true,
+		},
+		"SharedMicrosoftAD": {
+			directoryType: "SharedMicrosoftAD",
+			expectError:   true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validRadiusDirectoryType(test.directoryType)
+
+			if test.expectError && err == nil {
+				t.Error("expected an error")
+			}
+
+			if !test.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
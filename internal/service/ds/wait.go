@@ -69,6 +69,27 @@ func waitRadiusCompleted(ctx context.Context, conn *directoryservice.DirectorySe
 	return nil, err
 }
 
+func waitDirectorySettingUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, name string, timeout time.Duration) (*directoryservice.SettingEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating},
+		Target:  []string{directoryservice.DirectoryConfigurationStatusUpdated, directoryservice.DirectoryConfigurationStatusDefault},
+		Refresh: statusDirectorySetting(ctx, conn, directoryID, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SettingEntry); ok {
+		if aws.StringValue(output.RequestStatus) == directoryservice.DirectoryConfigurationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.RequestStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitRegionCreated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, regionName string, timeout time.Duration) (*directoryservice.RegionDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{directoryservice.DirectoryStageRequested, directoryservice.DirectoryStageCreating, directoryservice.DirectoryStageCreated},
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ebs_fast_snapshot_restore", name="EBS Fast Snapshot Restore")
+func ResourceFastSnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFastSnapshotRestoreCreate,
+		ReadWithoutTimeout:   resourceFastSnapshotRestoreRead,
+		DeleteWithoutTimeout: resourceFastSnapshotRestoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFastSnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	snapshotID := d.Get("snapshot_id").(string)
+	availabilityZone := d.Get("availability_zone").(string)
+	id := FastSnapshotRestoreCreateResourceID(snapshotID, availabilityZone)
+	input := &ec2.EnableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	}
+
+	output, err := conn.EnableFastSnapshotRestoresWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = EnableFastSnapshotRestoresError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EBS Fast Snapshot Restore (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitFastSnapshotRestoreCreated(ctx, conn, snapshotID, availabilityZone, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Fast Snapshot Restore (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFastSnapshotRestoreRead(ctx, d, meta)...)
+}
+
+func resourceFastSnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	snapshotID, availabilityZone, err := FastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindFastSnapshotRestoreByTwoPartKey(ctx, conn, snapshotID, availabilityZone)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Fast Snapshot Restore %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Fast Snapshot Restore (%s): %s", d.Id(), err)
+	}
+
+	d.Set("availability_zone", output.AvailabilityZone)
+	d.Set("snapshot_id", output.SnapshotId)
+	d.Set("state", output.State)
+
+	return diags
+}
+
+func resourceFastSnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	snapshotID, availabilityZone, err := FastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EBS Fast Snapshot Restore: %s", d.Id())
+	output, err := conn.DisableFastSnapshotRestoresWithContext(ctx, &ec2.DisableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	})
+
+	if err == nil && output != nil {
+		err = DisableFastSnapshotRestoresError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EBS Fast Snapshot Restore (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFastSnapshotRestoreDeleted(ctx, conn, snapshotID, availabilityZone, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Fast Snapshot Restore (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const fastSnapshotRestoreIDSeparator = ","
+
+func FastSnapshotRestoreCreateResourceID(snapshotID, availabilityZone string) string {
+	parts := []string{snapshotID, availabilityZone}
+	id := strings.Join(parts, fastSnapshotRestoreIDSeparator)
+
+	return id
+}
+
+func FastSnapshotRestoreParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, fastSnapshotRestoreIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SNAPSHOT_ID%[2]sAVAILABILITY_ZONE", id, fastSnapshotRestoreIDSeparator)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ec2_address_transfer", name="Address Transfer")
+func ResourceAddressTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAddressTransferCreate,
+		ReadWithoutTimeout:   resourceAddressTransferRead,
+		DeleteWithoutTimeout: resourceAddressTransferDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_transfer_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"transfer_offer_accepted_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_offer_expiration_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAddressTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	allocationID := d.Get("allocation_id").(string)
+	input := &ec2.EnableAddressTransferInput{
+		AllocationId:      aws.String(allocationID),
+		TransferAccountId: aws.String(d.Get("transfer_account_id").(string)),
+	}
+
+	_, err := conn.EnableAddressTransferWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Address Transfer (%s): %s", allocationID, err)
+	}
+
+	d.SetId(allocationID)
+
+	return append(diags, resourceAddressTransferRead(ctx, d, meta)...)
+}
+
+func resourceAddressTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+		return FindAddressTransferByAllocationID(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Address Transfer %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Address Transfer (%s): %s", d.Id(), err)
+	}
+
+	transfer := outputRaw.(*ec2.AddressTransfer)
+
+	d.Set("address_transfer_status", transfer.AddressTransferStatus)
+	d.Set("allocation_id", transfer.AllocationId)
+	d.Set("public_ip", transfer.PublicIp)
+	d.Set("transfer_account_id", transfer.TransferAccountId)
+	if transfer.TransferOfferAcceptedTimestamp != nil {
+		d.Set("transfer_offer_accepted_timestamp", aws.TimeValue(transfer.TransferOfferAcceptedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("transfer_offer_accepted_timestamp", nil)
+	}
+	if transfer.TransferOfferExpirationTimestamp != nil {
+		d.Set("transfer_offer_expiration_timestamp", aws.TimeValue(transfer.TransferOfferExpirationTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("transfer_offer_expiration_timestamp", nil)
+	}
+
+	return diags
+}
+
+func resourceAddressTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// An accepted transfer cannot be undone.
+	if d.Get("address_transfer_status").(string) == ec2.AddressTransferStatusAccepted {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Address Transfer: %s", d.Id())
+	_, err := conn.DisableAddressTransferWithContext(ctx, &ec2.DisableAddressTransferInput{
+		AllocationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Address Transfer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
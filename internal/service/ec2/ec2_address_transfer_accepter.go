@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_address_transfer_accepter", name="Address Transfer Accepter")
+// @Tags(identifierAttribute="id")
+func ResourceAddressTransferAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAddressTransferAccepterCreate,
+		ReadWithoutTimeout:   resourceAddressTransferAccepterRead,
+		UpdateWithoutTimeout: resourceAddressTransferAccepterUpdate,
+		DeleteWithoutTimeout: resourceAddressTransferAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAddressTransferAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	address := d.Get("address").(string)
+	input := &ec2.AcceptAddressTransferInput{
+		Address:           aws.String(address),
+		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypeElasticIp),
+	}
+
+	_, err := conn.AcceptAddressTransferWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "accepting EC2 Address Transfer (%s): %s", address, err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+		return FindEIPByPublicIP(ctx, conn, address)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 EIP (%s) to be transferred: %s", address, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*ec2.Address).AllocationId))
+
+	return append(diags, resourceAddressTransferAccepterRead(ctx, d, meta)...)
+}
+
+func resourceAddressTransferAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	address, err := FindEIPByAllocationID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Address Transfer Accepter %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address", address.PublicIp)
+	d.Set("allocation_id", address.AllocationId)
+
+	setTagsOut(ctx, address.Tags)
+
+	return diags
+}
+
+func resourceAddressTransferAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceAddressTransferAccepterRead(ctx, d, meta)...)
+}
+
+func resourceAddressTransferAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[INFO] Releasing EC2 EIP: %s", d.Id())
+	_, err := conn.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{
+		AllocationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "releasing EC2 EIP (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -767,6 +767,11 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"stop_before_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1992,6 +1997,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutDelete))
 
 	if err := disableInstanceAPITermination(ctx, conn, d.Id(), false); err != nil {
 		log.Printf("[WARN] attempting to terminate EC2 Instance (%s) despite error disabling API termination: %s", d.Id(), err)
@@ -2014,7 +2020,21 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if err := terminateInstance(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if d.Get("stop_before_destroy").(bool) && d.Get("instance_lifecycle").(string) != ec2.InstanceLifecycleSpot {
+		if err := disableInstanceAPIStop(ctx, conn, d.Id(), false); err != nil {
+			log.Printf("[WARN] attempting to stop EC2 Instance (%s) despite error disabling API stop: %s", d.Id(), err)
+		}
+
+		// Give the operating system the chance to shut down cleanly before the instance is terminated.
+		// Instances with an instance store root device cannot be stopped and are terminated directly.
+		if err := StopInstance(ctx, conn, d.Id(), deadline.Remaining()); tfawserr.ErrCodeEquals(err, errCodeUnsupportedOperation) {
+			log.Printf("[WARN] EC2 Instance (%s) cannot be stopped before termination: %s", d.Id(), err)
+		} else if err != nil {
+			return sdkdiag.AppendErrorf(diags, "stopping EC2 Instance (%s) before termination: %s", d.Id(), err)
+		}
+	}
+
+	if err := terminateInstance(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
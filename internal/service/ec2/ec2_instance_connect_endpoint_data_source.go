@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource(name="Instance Connect Endpoint")
+func newDataSourceInstanceConnectEndpoint(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceInstanceConnectEndpoint{}, nil
+}
+
+type dataSourceInstanceConnectEndpoint struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceInstanceConnectEndpoint) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ec2_instance_connect_endpoint"
+}
+
+func (d *dataSourceInstanceConnectEndpoint) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"availability_zone": schema.StringAttribute{
+				Computed: true,
+			},
+			"dns_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"fips_dns_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": framework.IDAttribute(),
+			"instance_connect_endpoint_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"network_interface_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"owner_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"preserve_client_ip": schema.BoolAttribute{
+				Computed: true,
+			},
+			"security_group_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"subnet_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"tags": tftags.TagsAttributeComputedOnly(),
+			"vpc_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": CustomFiltersBlock(),
+		},
+	}
+}
+
+func (d *dataSourceInstanceConnectEndpoint) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceInstanceConnectEndpointData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	input := &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: buildAttributeFilterListV2(map[string]string{
+			"subnet-id": data.SubnetID.ValueString(),
+			"vpc-id":    data.VPCID.ValueString(),
+		}),
+	}
+
+	if !data.InstanceConnectEndpointID.IsNull() {
+		input.InstanceConnectEndpointIds = []string{data.InstanceConnectEndpointID.ValueString()}
+	}
+
+	input.Filters = append(input.Filters, buildCustomFiltersV2(ctx, data.Filters)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	output, err := FindInstanceConnectEndpoint(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Instance Connect Endpoints", tfresource.SingularDataSourceFindError("EC2 Instance Connect Endpoint", err).Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.InstanceConnectEndpointArn)
+	data.AvailabilityZone = flex.StringToFramework(ctx, output.AvailabilityZone)
+	data.DNSName = flex.StringToFramework(ctx, output.DnsName)
+	data.FIPSDNSName = flex.StringToFramework(ctx, output.FipsDnsName)
+	data.ID = flex.StringToFramework(ctx, output.InstanceConnectEndpointId)
+	data.InstanceConnectEndpointID = flex.StringToFramework(ctx, output.InstanceConnectEndpointId)
+	data.NetworkInterfaceIDs = flex.FlattenFrameworkStringValueListLegacy(ctx, output.NetworkInterfaceIds)
+	data.OwnerID = flex.StringToFramework(ctx, output.OwnerId)
+	data.PreserveClientIP = flex.BoolToFramework(ctx, output.PreserveClientIp)
+	data.SecurityGroupIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, output.SecurityGroupIds)
+	data.State = types.StringValue(string(output.State))
+	data.SubnetID = flex.StringToFramework(ctx, output.SubnetId)
+	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, keyValueTagsV2(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+	data.VPCID = flex.StringToFramework(ctx, output.VpcId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceInstanceConnectEndpointData struct {
+	ARN                       types.String `tfsdk:"arn"`
+	AvailabilityZone          types.String `tfsdk:"availability_zone"`
+	DNSName                   types.String `tfsdk:"dns_name"`
+	FIPSDNSName               types.String `tfsdk:"fips_dns_name"`
+	Filters                   types.Set    `tfsdk:"filter"`
+	ID                        types.String `tfsdk:"id"`
+	InstanceConnectEndpointID types.String `tfsdk:"instance_connect_endpoint_id"`
+	NetworkInterfaceIDs       types.List   `tfsdk:"network_interface_ids"`
+	OwnerID                   types.String `tfsdk:"owner_id"`
+	PreserveClientIP          types.Bool   `tfsdk:"preserve_client_ip"`
+	SecurityGroupIDs          types.Set    `tfsdk:"security_group_ids"`
+	State                     types.String `tfsdk:"state"`
+	SubnetID                  types.String `tfsdk:"subnet_id"`
+	Tags                      types.Map    `tfsdk:"tags"`
+	VPCID                     types.String `tfsdk:"vpc_id"`
+}
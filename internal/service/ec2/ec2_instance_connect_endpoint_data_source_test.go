@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2InstanceConnectEndpointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_connect_endpoint.test"
+	datasource1Name := "data.aws_ec2_instance_connect_endpoint.by_id"
+	datasource2Name := "data.aws_ec2_instance_connect_endpoint.by_subnet"
+	datasource3Name := "data.aws_ec2_instance_connect_endpoint.by_filter"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConnectEndpointDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasource1Name, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "availability_zone", resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "dns_name", resourceName, "dns_name"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "fips_dns_name", resourceName, "fips_dns_name"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "network_interface_ids.#", resourceName, "network_interface_ids.#"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "owner_id", resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "preserve_client_ip", resourceName, "preserve_client_ip"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "security_group_ids.#", resourceName, "security_group_ids.#"),
+					resource.TestCheckResourceAttr(datasource1Name, "state", "create-complete"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "subnet_id", resourceName, "subnet_id"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "vpc_id", resourceName, "vpc_id"),
+
+					resource.TestCheckResourceAttrPair(datasource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasource2Name, "vpc_id", resourceName, "vpc_id"),
+
+					resource.TestCheckResourceAttrPair(datasource3Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasource3Name, "subnet_id", resourceName, "subnet_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceConnectEndpointDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConnectEndpointConfig_tags1(rName, "Name", rName), `
+data "aws_ec2_instance_connect_endpoint" "by_id" {
+  instance_connect_endpoint_id = aws_ec2_instance_connect_endpoint.test.id
+}
+
+data "aws_ec2_instance_connect_endpoint" "by_subnet" {
+  subnet_id = aws_ec2_instance_connect_endpoint.test.subnet_id
+  vpc_id    = aws_ec2_instance_connect_endpoint.test.vpc_id
+}
+
+data "aws_ec2_instance_connect_endpoint" "by_filter" {
+  filter {
+    name   = "instance-connect-endpoint-id"
+    values = [aws_ec2_instance_connect_endpoint.test.id]
+  }
+}
+`)
+}
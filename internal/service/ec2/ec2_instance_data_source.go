@@ -49,6 +49,26 @@ func DataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cpu_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amd_sev_snp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"core_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"threads_per_core": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"credit_specification": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -614,6 +634,10 @@ func instanceDescriptionAttributes(ctx context.Context, d *schema.ResourceData,
 		d.Set("credit_specification", nil)
 	}
 
+	if err := d.Set("cpu_options", flattenCPUOptions(instance.CpuOptions)); err != nil {
+		return fmt.Errorf("setting cpu_options: %w", err)
+	}
+
 	if err := d.Set("enclave_options", flattenEnclaveOptions(instance.EnclaveOptions)); err != nil {
 		return fmt.Errorf("setting enclave_options: %w", err)
 	}
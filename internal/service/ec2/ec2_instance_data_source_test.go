@@ -594,6 +594,30 @@ func TestAccEC2InstanceDataSource_autoRecovery(t *testing.T) {
 	})
 }
 
+func TestAccEC2InstanceDataSource_cpuOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_instance.test"
+	datasourceName := "data.aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceDataSourceConfig_cpuOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "cpu_options.#", resourceName, "cpu_options.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "cpu_options.0.amd_sev_snp", resourceName, "cpu_options.0.amd_sev_snp"),
+					resource.TestCheckResourceAttrPair(datasourceName, "cpu_options.0.core_count", resourceName, "cpu_options.0.core_count"),
+					resource.TestCheckResourceAttrPair(datasourceName, "cpu_options.0.threads_per_core", resourceName, "cpu_options.0.threads_per_core"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2InstanceDataSource_creditSpecification(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_instance.test"
@@ -1295,6 +1319,32 @@ data "aws_instance" "test" {
 `, rName, val))
 }
 
+func testAccInstanceDataSourceConfig_cpuOptions(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 1),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "c5.xlarge"
+  subnet_id     = aws_subnet.test.id
+
+  cpu_options {
+    core_count       = 2
+    threads_per_core = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_instance" "test" {
+  instance_id = aws_instance.test.id
+}
+`, rName))
+}
+
 func testAccInstanceDataSourceConfig_creditSpecification(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
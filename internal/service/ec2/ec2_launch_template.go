@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -971,6 +972,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			resourceLaunchTemplateCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -1159,6 +1161,137 @@ func resourceLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// resourceLaunchTemplateCustomizeDiff validates the launch template data against the capabilities of the configured instance type.
+// Combinations that EC2 would otherwise only reject at launch time with InvalidParameterCombination are reported during plan.
+func resourceLaunchTemplateCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("instance_type", "cpu_options", "ebs_optimized", "hibernation_options", "network_interfaces") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("instance_type") {
+		return nil
+	}
+
+	instanceType := diff.Get("instance_type").(string)
+
+	if instanceType == "" {
+		return nil
+	}
+
+	client := meta.(*conns.AWSClient)
+	instanceTypeInfo, err := launchTemplateInstanceTypes.get(ctx, client.EC2Conn(ctx), client.Region, instanceType)
+
+	// Don't block the plan if the instance type can't be described (e.g. missing permissions); EC2 validates at launch.
+	if err != nil {
+		log.Printf("[WARN] skipping EC2 Launch Template instance type (%s) validation: %s", instanceType, err)
+		return nil
+	}
+
+	if v, ok := diff.GetOk("cpu_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if tfMap["amd_sev_snp"].(string) == ec2.AmdSevSnpSpecificationEnabled {
+			var supported bool
+
+			if v := instanceTypeInfo.ProcessorInfo; v != nil {
+				for _, v := range v.SupportedFeatures {
+					if aws.StringValue(v) == ec2.SupportedAdditionalProcessorFeatureAmdSevSnp {
+						supported = true
+						break
+					}
+				}
+			}
+
+			if !supported {
+				return fmt.Errorf("cpu_options.0.amd_sev_snp: instance type %s does not support AMD SEV-SNP", instanceType)
+			}
+		}
+	}
+
+	if v, null, _ := nullable.Bool(diff.Get("ebs_optimized").(string)).Value(); !null && v {
+		if v := instanceTypeInfo.EbsInfo; v != nil && aws.StringValue(v.EbsOptimizedSupport) == ec2.EbsOptimizedSupportUnsupported {
+			return fmt.Errorf("ebs_optimized: instance type %s does not support EBS optimization", instanceType)
+		}
+	}
+
+	if v, ok := diff.GetOk("hibernation_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if tfMap["configured"].(bool) && !aws.BoolValue(instanceTypeInfo.HibernationSupported) {
+			return fmt.Errorf("hibernation_options.0.configured: instance type %s does not support hibernation", instanceType)
+		}
+	}
+
+	if v, ok := diff.GetOk("network_interfaces"); ok && len(v.([]interface{})) > 0 {
+		tfList := v.([]interface{})
+		networkInfo := instanceTypeInfo.NetworkInfo
+
+		if networkInfo == nil {
+			return nil
+		}
+
+		if v := aws.Int64Value(networkInfo.MaximumNetworkInterfaces); v > 0 && int64(len(tfList)) > v {
+			return fmt.Errorf("network_interfaces: instance type %s supports at most %d network interfaces, got %d", instanceType, v, len(tfList))
+		}
+
+		for i, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap["network_card_index"].(int); ok {
+				if maximum := aws.Int64Value(networkInfo.MaximumNetworkCards); maximum > 0 && int64(v) >= maximum {
+					return fmt.Errorf("network_interfaces.%d.network_card_index: instance type %s supports %d network cards, got index %d", i, instanceType, maximum, v)
+				}
+			}
+
+			if v, ok := tfMap["interface_type"].(string); ok && v == ec2.NetworkInterfaceTypeEfa && !aws.BoolValue(networkInfo.EfaSupported) {
+				return fmt.Errorf("network_interfaces.%d.interface_type: instance type %s does not support Elastic Fabric Adapter (EFA)", i, instanceType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// launchTemplateInstanceTypes caches DescribeInstanceTypes results for plan-time validation.
+// Instance type capabilities are static, so lookups are shared across resources for the lifetime of the provider process.
+var launchTemplateInstanceTypes = &instanceTypeInfoCache{
+	infos: make(map[string]*ec2.InstanceTypeInfo),
+}
+
+type instanceTypeInfoCache struct {
+	mutex sync.Mutex
+	infos map[string]*ec2.InstanceTypeInfo
+}
+
+func (c *instanceTypeInfoCache) get(ctx context.Context, conn *ec2.EC2, region, instanceType string) (*ec2.InstanceTypeInfo, error) {
+	key := region + "/" + instanceType
+
+	c.mutex.Lock()
+	v, ok := c.infos[key]
+	c.mutex.Unlock()
+
+	if ok {
+		return v, nil
+	}
+
+	// Don't hold the lock during the lookup. Concurrent lookups of the same instance type return the same result.
+	v, err := FindInstanceTypeByName(ctx, conn, instanceType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	c.infos[key] = v
+	c.mutex.Unlock()
+
+	return v, nil
+}
+
 func expandRequestLaunchTemplateData(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
 	apiObject := &ec2.RequestLaunchTemplateData{
 		// Always set at least one field.
@@ -980,6 +980,32 @@ func TestAccEC2LaunchTemplate_networkInterfaceCardIndex(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_instanceTypeCapabilities(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLaunchTemplateConfig_instanceTypeCapabilitiesNetworkCardIndex(rName),
+				ExpectError: regexp.MustCompile(`instance type t3.micro supports 1 network cards, got index 1`),
+			},
+			{
+				Config:      testAccLaunchTemplateConfig_instanceTypeCapabilitiesEFA(rName),
+				ExpectError: regexp.MustCompile(`instance type t3.micro does not support Elastic Fabric Adapter`),
+			},
+			{
+				Config:      testAccLaunchTemplateConfig_instanceTypeCapabilitiesEBSOptimized(rName),
+				ExpectError: regexp.MustCompile(`instance type t2.micro does not support EBS optimization`),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_networkInterfaceIPv4PrefixCount(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template ec2.LaunchTemplate
@@ -3891,6 +3917,42 @@ resource "aws_launch_template" "test" {
 `, rName)
 }
 
+func testAccLaunchTemplateConfig_instanceTypeCapabilitiesNetworkCardIndex(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t3.micro"
+
+  network_interfaces {
+    network_card_index = 1
+  }
+}
+`, rName)
+}
+
+func testAccLaunchTemplateConfig_instanceTypeCapabilitiesEFA(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t3.micro"
+
+  network_interfaces {
+    interface_type = "efa"
+  }
+}
+`, rName)
+}
+
+func testAccLaunchTemplateConfig_instanceTypeCapabilitiesEBSOptimized(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t2.micro"
+  ebs_optimized = "true"
+}
+`, rName)
+}
+
 func testAccLaunchTemplateConfig_networkInterfaceIPv4PrefixCount(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -63,6 +63,7 @@ func ResourceSpotInstanceRequest() *schema.Resource {
 			delete(s, "instance_lifecycle")
 			delete(s, "instance_market_options")
 			delete(s, "spot_instance_request_id")
+			delete(s, "stop_before_destroy")
 
 			s["block_duration_minutes"] = &schema.Schema{
 				Type:         schema.TypeInt,
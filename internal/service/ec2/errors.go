@@ -27,6 +27,7 @@ const (
 	errCodeInvalidAllocationIDNotFound                       = "InvalidAllocationID.NotFound"
 	errCodeInvalidAssociationIDNotFound                      = "InvalidAssociationID.NotFound"
 	errCodeInvalidAttachmentIDNotFound                       = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIdNotFound         = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound              = "InvalidCapacityReservationId.NotFound'"
 	errCodeInvalidCarrierGatewayIDNotFound                   = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound         = "InvalidClientVpnActiveAssociationNotFound"
@@ -120,6 +121,22 @@ const (
 	errCodeVPNGatewayLimitExceeded                           = "VpnGatewayLimitExceeded"
 )
 
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.CancelCapacityReservationFleetError == nil {
+			continue
+		}
+
+		v := apiObject.CancelCapacityReservationFleetError
+		err := awserr.New(aws.StringValue(v.Code), aws.StringValue(v.Message), nil)
+		errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
@@ -160,6 +177,48 @@ func DeleteFleetsError(apiObjects []*ec2.DeleteFleetErrorItem) error {
 	return errors.ErrorOrNil()
 }
 
+func EnableFastSnapshotRestoresError(apiObjects []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil || v.Error == nil {
+				continue
+			}
+
+			err := awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func DisableFastSnapshotRestoresError(apiObjects []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil || v.Error == nil {
+				continue
+			}
+
+			err := awserr.New(aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message), nil)
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func UnsuccessfulItemError(apiObject *ec2.UnsuccessfulItemError) error {
 	if apiObject == nil {
 		return nil
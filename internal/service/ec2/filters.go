@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -196,3 +197,32 @@ func BuildCustomFilters(ctx context.Context, filterSet types.Set) []*ec2.Filter
 
 	return filters
 }
+
+func buildCustomFiltersV2(ctx context.Context, filterSet types.Set) []awstypes.Filter {
+	if filterSet.IsNull() || filterSet.IsUnknown() {
+		return nil
+	}
+
+	var filters []awstypes.Filter
+
+	for _, v := range filterSet.Elements() {
+		var data customFilterData
+
+		if tfsdk.ValueAs(ctx, v, &data).HasError() {
+			continue
+		}
+
+		if data.Name.IsNull() || data.Name.IsUnknown() {
+			continue
+		}
+
+		if v := flex.ExpandFrameworkStringValueSet(ctx, data.Values); v != nil {
+			filters = append(filters, awstypes.Filter{
+				Name:   flex.StringFromFramework(ctx, data.Name),
+				Values: v,
+			})
+		}
+	}
+
+	return filters
+}
@@ -21,6 +21,83 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
+func FindAddressTransfer(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAddressTransfersInput) (*ec2.AddressTransfer, error) {
+	output, err := FindAddressTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindAddressTransfers(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAddressTransfersInput) ([]*ec2.AddressTransfer, error) {
+	var output []*ec2.AddressTransfer
+
+	err := conn.DescribeAddressTransfersPagesWithContext(ctx, input, func(page *ec2.DescribeAddressTransfersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AddressTransfers {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindAddressTransferByAllocationID(ctx context.Context, conn *ec2.EC2, allocationID string) (*ec2.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: aws.StringSlice([]string{allocationID}),
+	}
+
+	output, err := FindAddressTransfer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.AddressTransferStatus); state == ec2.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.AllocationId) != allocationID {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindAvailabilityZones(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAvailabilityZonesInput) ([]*ec2.AvailabilityZone, error) {
 	output, err := conn.DescribeAvailabilityZonesWithContext(ctx, input)
 
@@ -162,6 +239,83 @@ func FindCapacityReservationByID(ctx context.Context, conn *ec2.EC2, id string)
 	return output, nil
 }
 
+func FindCapacityReservationFleet(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) (*ec2.CapacityReservationFleet, error) {
+	output, err := FindCapacityReservationFleets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindCapacityReservationFleets(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) ([]*ec2.CapacityReservationFleet, error) {
+	var output []*ec2.CapacityReservationFleet
+
+	err := conn.DescribeCapacityReservationFleetsPagesWithContext(ctx, input, func(page *ec2.DescribeCapacityReservationFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservationFleets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindCapacityReservationFleetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.CapacityReservationFleet, error) {
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindCapacityReservationFleet(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.CapacityReservationFleetStateCancelled || state == ec2.CapacityReservationFleetStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.CapacityReservationFleetId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindCarrierGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCarrierGatewaysInput) (*ec2.CarrierGateway, error) {
 	output, err := FindCarrierGateways(ctx, conn, input)
 
@@ -797,6 +951,27 @@ func FindEIPByAssociationID(ctx context.Context, conn *ec2.EC2, id string) (*ec2
 	return output, nil
 }
 
+func FindEIPByPublicIP(ctx context.Context, conn *ec2.EC2, ip string) (*ec2.Address, error) {
+	input := &ec2.DescribeAddressesInput{
+		PublicIps: aws.StringSlice([]string{ip}),
+	}
+
+	output, err := FindEIP(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.PublicIp) != ip {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindHostByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Host, error) {
 	input := &ec2.DescribeHostsInput{
 		HostIds: aws.StringSlice([]string{id}),
@@ -3627,6 +3802,51 @@ func FindVPNConnection(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVp
 	return output[0], nil
 }
 
+func FindVPNConnectionDeviceTypes(ctx context.Context, conn *ec2.EC2, input *ec2.GetVpnConnectionDeviceTypesInput) ([]*ec2.VpnConnectionDeviceType, error) {
+	var output []*ec2.VpnConnectionDeviceType
+
+	err := conn.GetVpnConnectionDeviceTypesPagesWithContext(ctx, input, func(page *ec2.GetVpnConnectionDeviceTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VpnConnectionDeviceTypes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVPNConnectionDeviceSampleConfiguration(ctx context.Context, conn *ec2.EC2, input *ec2.GetVpnConnectionDeviceSampleConfigurationInput) (string, error) {
+	output, err := conn.GetVpnConnectionDeviceSampleConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPNConnectionIDNotFound) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.VpnConnectionDeviceSampleConfiguration == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.VpnConnectionDeviceSampleConfiguration), nil
+}
+
 func FindVPNConnectionRouteByVPNConnectionIDAndCIDR(ctx context.Context, conn *ec2.EC2, vpnConnectionID, cidrBlock string) (*ec2.VpnStaticRoute, error) {
 	input := &ec2.DescribeVpnConnectionsInput{
 		Filters: BuildAttributeFilterList(map[string]string{
@@ -6809,6 +7029,72 @@ func FindCreateSnapshotCreateVolumePermissionByTwoPartKey(ctx context.Context, c
 	return nil, &retry.NotFoundError{LastRequest: input}
 }
 
+func FindFastSnapshotRestores(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastSnapshotRestoresInput) ([]*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	var output []*ec2.DescribeFastSnapshotRestoreSuccessItem
+
+	err := conn.DescribeFastSnapshotRestoresPagesWithContext(ctx, input, func(page *ec2.DescribeFastSnapshotRestoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FastSnapshotRestores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindFastSnapshotRestore(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastSnapshotRestoresInput) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	output, err := FindFastSnapshotRestores(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindFastSnapshotRestoreByTwoPartKey(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	input := &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"availability-zone": availabilityZone,
+			"snapshot-id":       snapshotID,
+		}),
+	}
+
+	output, err := FindFastSnapshotRestore(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.FastSnapshotRestoreStateCodeDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindFindSnapshotTierStatuses(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSnapshotTierStatusInput) ([]*ec2.SnapshotTierStatus, error) {
 	var output []*ec2.SnapshotTierStatus
 
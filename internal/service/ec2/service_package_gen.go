@@ -19,6 +19,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceInstanceConnectEndpoint,
+			Name:    "Instance Connect Endpoint",
+		},
 		{
 			Factory: newDataSourceSecurityGroupRule,
 		},
@@ -51,6 +55,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory: newResourceSecurityGroupRulesExclusive,
+			Name:    "Security Group Rules Exclusive",
+		},
 	}
 }
 
@@ -376,6 +384,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCs,
 			TypeName: "aws_vpcs",
 		},
+		{
+			Factory:  DataSourceVPNConnectionDeviceSampleConfiguration,
+			TypeName: "aws_vpn_connection_device_sample_configuration",
+		},
 		{
 			Factory:  DataSourceVPNGateway,
 			TypeName: "aws_vpn_gateway",
@@ -477,6 +489,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEBSEncryptionByDefault,
 			TypeName: "aws_ebs_encryption_by_default",
 		},
+		{
+			Factory:  ResourceFastSnapshotRestore,
+			TypeName: "aws_ebs_fast_snapshot_restore",
+			Name:     "EBS Fast Snapshot Restore",
+		},
 		{
 			Factory:  ResourceEBSSnapshot,
 			TypeName: "aws_ebs_snapshot",
@@ -509,6 +526,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceAddressTransfer,
+			TypeName: "aws_ec2_address_transfer",
+			Name:     "Address Transfer",
+		},
+		{
+			Factory:  ResourceAddressTransferAccepter,
+			TypeName: "aws_ec2_address_transfer_accepter",
+			Name:     "Address Transfer Accepter",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceAvailabilityZoneGroup,
 			TypeName: "aws_ec2_availability_zone_group",
@@ -521,6 +551,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceCapacityReservationFleet,
+			TypeName: "aws_ec2_capacity_reservation_fleet",
+			Name:     "Capacity Reservation Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceCarrierGateway,
 			TypeName: "aws_ec2_carrier_gateway",
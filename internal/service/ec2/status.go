@@ -46,6 +46,22 @@ func StatusCapacityReservationState(ctx context.Context, conn *ec2.EC2, id strin
 	}
 }
 
+func StatusCapacityReservationFleetState(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCapacityReservationFleetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusCarrierGatewayState(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindCarrierGatewayByID(ctx, conn, id)
@@ -1337,6 +1353,22 @@ func statusVPCEndpointConnectionVPCEndpointState(ctx context.Context, conn *ec2.
 	}
 }
 
+func statusFastSnapshotRestore(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFastSnapshotRestoreByTwoPartKey(ctx, conn, snapshotID, availabilityZone)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusSnapshotStorageTier(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindSnapshotTierStatusBySnapshotID(ctx, conn, id)
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -159,5 +160,41 @@ func resourceVPCIPv4CIDRBlockAssociationDelete(ctx context.Context, d *schema.Re
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC IPv4 CIDR block (%s) to become disassociated: %s", d.Id(), err)
 	}
 
+	// If the CIDR block was allocated from an IPAM pool, wait for the allocation to disappear
+	// so that the pool (or its CIDR) can be deleted straight afterwards.
+	if ipamPoolID := d.Get("ipv4_ipam_pool_id").(string); ipamPoolID != "" {
+		const (
+			timeout = 20 * time.Minute // IPAM eventual consistency
+		)
+		vpcID, cidrBlock := d.Get("vpc_id").(string), d.Get("cidr_block").(string)
+		_, err := tfresource.RetryUntilNotFound(ctx, timeout, func() (interface{}, error) {
+			return findIPAMPoolAllocationForVPCCIDRBlock(ctx, conn, ipamPoolID, vpcID, cidrBlock)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC IPv4 CIDR Block Association (%s) IPAM Pool (%s) Allocation delete: %s", d.Id(), ipamPoolID, err)
+		}
+	}
+
 	return diags
 }
+
+func findIPAMPoolAllocationForVPCCIDRBlock(ctx context.Context, conn *ec2.EC2, poolID, vpcID, cidrBlock string) (*ec2.IpamPoolAllocation, error) {
+	input := &ec2.GetIpamPoolAllocationsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	output, err := FindIPAMPoolAllocations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.ResourceType) == ec2.IpamPoolAllocationResourceTypeVpc && aws.StringValue(v.ResourceId) == vpcID && aws.StringValue(v.Cidr) == cidrBlock {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{LastRequest: input}
+}
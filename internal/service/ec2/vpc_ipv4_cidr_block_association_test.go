@@ -134,6 +134,39 @@ func TestAccVPCIPv4CIDRBlockAssociation_ipamBasicExplicitCIDR(t *testing.T) {
 	})
 }
 
+func TestAccVPCIPv4CIDRBlockAssociation_ipamPoolDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var associationSecondary ec2.VpcCidrBlockAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCIPv4CIDRBlockAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPv4CIDRBlockAssociationConfig_ipam(rName, 28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPv4CIDRBlockAssociationExists(ctx, "aws_vpc_ipv4_cidr_block_association.secondary_cidr", &associationSecondary),
+				),
+			},
+			// Destroy the association, the IPAM pool CIDR and the IPAM pool in a single apply.
+			// The pool CIDR can only be deprovisioned once the association's allocation has been released.
+			{
+				Config: testAccVPCIPv4CIDRBlockAssociationConfig_ipamPoolDestroyed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPv4CIDRBlockAssociationNotExists(ctx, &associationSecondary),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAdditionalVPCIPv4CIDRBlock(association *ec2.VpcCidrBlockAssociation, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		CIDRBlock := association.CidrBlock
@@ -181,6 +214,24 @@ func testAccCheckVPCIPv4CIDRBlockAssociationDestroy(ctx context.Context) resourc
 	}
 }
 
+func testAccCheckVPCIPv4CIDRBlockAssociationNotExists(ctx context.Context, v *ec2.VpcCidrBlockAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, _, err := tfec2.FindVPCCIDRBlockAssociationByID(ctx, conn, aws.StringValue(v.AssociationId))
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPv4 CIDR Block Association %s still exists", aws.StringValue(v.AssociationId))
+	}
+}
+
 func testAccCheckVPCIPv4CIDRBlockAssociationExists(ctx context.Context, n string, v *ec2.VpcCidrBlockAssociation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -267,3 +318,27 @@ resource "aws_vpc_ipv4_cidr_block_association" "secondary_cidr" {
 }
 `, rName, cidr))
 }
+
+func testAccVPCIPv4CIDRBlockAssociationConfig_ipamPoolDestroyed(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
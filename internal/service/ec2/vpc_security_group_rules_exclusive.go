@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Security Group Rules Exclusive")
+func newResourceSecurityGroupRulesExclusive(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSecurityGroupRulesExclusive{}, nil
+}
+
+type resourceSecurityGroupRulesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules_exclusive"
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ingress_rule_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSecurityGroupRulesExclusiveData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+
+	if err := r.syncRules(ctx, &data); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group Rules Exclusive (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(securityGroupID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSecurityGroupRulesExclusiveData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	securityGroupID := data.ID.ValueString()
+
+	if _, err := FindSecurityGroupByID(ctx, conn, securityGroupID); tfresource.NotFound(err) {
+		tflog.Warn(ctx, "VPC Security Group not found, removing VPC Security Group Rules Exclusive from state", map[string]interface{}{
+			"id": securityGroupID,
+		})
+		response.State.RemoveResource(ctx)
+
+		return
+	} else if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group Rules Exclusive (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	ingressRuleIDs, egressRuleIDs, err := findSecurityGroupRuleIDsByGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group Rules Exclusive (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	data.EgressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, egressRuleIDs)
+	data.IngressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, ingressRuleIDs)
+	data.SecurityGroupID = types.StringValue(securityGroupID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceSecurityGroupRulesExclusiveData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncRules(ctx, &new); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group Rules Exclusive (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete only removes the resource from state. The security group's rules are left untouched.
+func (r *resourceSecurityGroupRulesExclusive) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+// syncRules revokes any rule in the security group that is not in the configured set of rule IDs.
+func (r *resourceSecurityGroupRulesExclusive) syncRules(ctx context.Context, data *resourceSecurityGroupRulesExclusiveData) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	ingressRuleIDs, egressRuleIDs, err := findSecurityGroupRuleIDsByGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return fmt.Errorf("reading VPC Security Group (%s) rules: %w", securityGroupID, err)
+	}
+
+	wantIngressRuleIDs := flex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs)
+	wantEgressRuleIDs := flex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)
+
+	if v := wantIngressRuleIDs.Difference(ingressRuleIDs); len(v) > 0 {
+		return fmt.Errorf("ingress rules %v not found in VPC Security Group (%s)", v, securityGroupID)
+	}
+
+	if v := wantEgressRuleIDs.Difference(egressRuleIDs); len(v) > 0 {
+		return fmt.Errorf("egress rules %v not found in VPC Security Group (%s)", v, securityGroupID)
+	}
+
+	if v := ingressRuleIDs.Difference(wantIngressRuleIDs); len(v) > 0 {
+		tflog.Debug(ctx, "revoking VPC Security Group ingress rules", map[string]interface{}{
+			"id":                      securityGroupID,
+			"security_group_rule_ids": v,
+		})
+
+		_, err := conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(v),
+		})
+
+		if err != nil {
+			return fmt.Errorf("revoking VPC Security Group (%s) ingress rules: %w", securityGroupID, err)
+		}
+	}
+
+	if v := egressRuleIDs.Difference(wantEgressRuleIDs); len(v) > 0 {
+		tflog.Debug(ctx, "revoking VPC Security Group egress rules", map[string]interface{}{
+			"id":                      securityGroupID,
+			"security_group_rule_ids": v,
+		})
+
+		_, err := conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(v),
+		})
+
+		if err != nil {
+			return fmt.Errorf("revoking VPC Security Group (%s) egress rules: %w", securityGroupID, err)
+		}
+	}
+
+	return nil
+}
+
+func findSecurityGroupRuleIDsByGroupID(ctx context.Context, conn *ec2.EC2, securityGroupID string) (flex.Set[string], flex.Set[string], error) {
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"group-id": securityGroupID,
+		}),
+	}
+
+	output, err := FindSecurityGroupRules(ctx, conn, input)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingressRuleIDs, egressRuleIDs flex.Set[string]
+
+	for _, v := range output {
+		if aws.BoolValue(v.IsEgress) {
+			egressRuleIDs = append(egressRuleIDs, aws.StringValue(v.SecurityGroupRuleId))
+		} else {
+			ingressRuleIDs = append(ingressRuleIDs, aws.StringValue(v.SecurityGroupRuleId))
+		}
+	}
+
+	return ingressRuleIDs, egressRuleIDs, nil
+}
+
+type resourceSecurityGroupRulesExclusiveData struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	ID              types.String `tfsdk:"id"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
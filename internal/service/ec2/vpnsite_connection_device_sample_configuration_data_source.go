@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_vpn_connection_device_sample_configuration")
+func DataSourceVPNConnectionDeviceSampleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPNConnectionDeviceSampleConfigurationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"internet_key_exchange_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ikev1", "ikev2"}, false),
+			},
+			"platform": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"software": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"vendor": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"vpn_connection_device_sample_configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"vpn_connection_device_type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"vendor", "vpn_connection_device_type_id"},
+			},
+			"vpn_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPNConnectionDeviceSampleConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	vpnConnectionID := d.Get("vpn_connection_id").(string)
+
+	deviceTypes, err := FindVPNConnectionDeviceTypes(ctx, conn, &ec2.GetVpnConnectionDeviceTypesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection Device Types: %s", err)
+	}
+
+	var matches []*ec2.VpnConnectionDeviceType
+
+	for _, v := range deviceTypes {
+		if id, ok := d.GetOk("vpn_connection_device_type_id"); ok {
+			if aws.StringValue(v.VpnConnectionDeviceTypeId) == id.(string) {
+				matches = append(matches, v)
+			}
+			continue
+		}
+
+		if vendor := d.Get("vendor").(string); aws.StringValue(v.Vendor) != vendor {
+			continue
+		}
+
+		if platform, ok := d.GetOk("platform"); ok && aws.StringValue(v.Platform) != platform.(string) {
+			continue
+		}
+
+		if software, ok := d.GetOk("software"); ok && aws.StringValue(v.Software) != software.(string) {
+			continue
+		}
+
+		matches = append(matches, v)
+	}
+
+	if len(matches) == 0 {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection Device Types: %s", tfresource.NewEmptyResultError(nil))
+	}
+
+	if count := len(matches); count > 1 {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection Device Types: %s", tfresource.NewTooManyResultsError(count, nil))
+	}
+
+	deviceType := matches[0]
+	deviceTypeID := aws.StringValue(deviceType.VpnConnectionDeviceTypeId)
+
+	input := &ec2.GetVpnConnectionDeviceSampleConfigurationInput{
+		VpnConnectionDeviceTypeId: aws.String(deviceTypeID),
+		VpnConnectionId:           aws.String(vpnConnectionID),
+	}
+
+	if v, ok := d.GetOk("internet_key_exchange_version"); ok {
+		input.InternetKeyExchangeVersion = aws.String(v.(string))
+	}
+
+	sampleConfiguration, err := FindVPNConnectionDeviceSampleConfiguration(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s) device sample configuration: %s", vpnConnectionID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", vpnConnectionID, deviceTypeID))
+	d.Set("platform", deviceType.Platform)
+	d.Set("software", deviceType.Software)
+	d.Set("vendor", deviceType.Vendor)
+	d.Set("vpn_connection_device_sample_configuration", sampleConfiguration)
+	d.Set("vpn_connection_device_type_id", deviceTypeID)
+
+	return diags
+}
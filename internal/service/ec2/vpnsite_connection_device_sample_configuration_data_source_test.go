@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSiteVPNConnectionDeviceSampleConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	dataSourceName := "data.aws_vpn_connection_device_sample_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_basic(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpn_connection_id", "aws_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "vendor", "Generic"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpn_connection_device_type_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpn_connection_device_sample_configuration"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_basic(rName string, rBgpAsn int) string {
+	return acctest.ConfigCompose(testAccSiteVPNConnectionConfig_basic(rName, rBgpAsn), `
+data "aws_vpn_connection_device_sample_configuration" "test" {
+  vpn_connection_id             = aws_vpn_connection.test.id
+  vendor                        = "Generic"
+  platform                      = "Generic"
+  software                      = "Vendor Agnostic"
+  internet_key_exchange_version = "ikev2"
+}
+`)
+}
@@ -121,6 +121,46 @@ func WaitCapacityReservationDeleted(ctx context.Context, conn *ec2.EC2, id strin
 	return nil, err
 }
 
+func WaitCapacityReservationFleetActive(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted, ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			ec2.CapacityReservationFleetStateActive,
+			ec2.CapacityReservationFleetStateCancelling,
+			ec2.CapacityReservationFleetStateModifying,
+			ec2.CapacityReservationFleetStatePartiallyFulfilled,
+			ec2.CapacityReservationFleetStateSubmitted,
+		},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
@@ -2860,6 +2900,46 @@ func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.EC2, servi
 	return nil, err
 }
 
+func waitFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: statusFastSnapshotRestore(ctx, conn, snapshotID, availabilityZone),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.EC2, snapshotID, availabilityZone string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeDisabling, ec2.FastSnapshotRestoreStateCodeEnabled, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{},
+		Refresh: statusFastSnapshotRestore(ctx, conn, snapshotID, availabilityZone),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	ebsSnapshotArchivedTimeout = 60 * time.Minute
 )
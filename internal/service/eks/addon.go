@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,7 +39,10 @@ func ResourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAddonCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -325,3 +330,37 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return diags
 }
+
+// resourceAddonCustomizeDiff validates configuration_values against the add-on version's
+// configuration schema so that mistakes are reported at plan time rather than on apply.
+func resourceAddonCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("addon_name") || !diff.NewValueKnown("addon_version") || !diff.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	addonName, addonVersion, configurationValues := diff.Get("addon_name").(string), diff.Get("addon_version").(string), diff.Get("configuration_values").(string)
+
+	// Without an explicit version the add-on's default version is not known until apply.
+	if addonVersion == "" || configurationValues == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	output, err := FindAddonConfigurationByAddonNameAndAddonVersion(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to validate EKS Add-On (%s) configuration_values: reading configuration schema for version %s: %s", addonName, addonVersion, err)
+		return nil
+	}
+
+	if err := validateAddonConfigurationValues(aws.StringValue(output.ConfigurationSchema), configurationValues); err != nil {
+		return fmt.Errorf("configuration_values are not valid for EKS Add-On %s %s: %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
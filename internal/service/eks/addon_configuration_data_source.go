@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_eks_addon_configuration")
+func DataSourceAddonConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAddonConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"addon_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"configuration_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAddonConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn(ctx)
+
+	addonName := d.Get("addon_name").(string)
+	addonVersion := d.Get("addon_version").(string)
+
+	output, err := FindAddonConfigurationByAddonNameAndAddonVersion(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		return diag.Errorf("reading EKS Add-On configuration (%s, %s): %s", addonName, addonVersion, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", addonName, addonVersion))
+	d.Set("addon_name", output.AddonName)
+	d.Set("addon_version", output.AddonVersion)
+	d.Set("configuration_schema", output.ConfigurationSchema)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEKSAddonConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_eks_addon_configuration.test"
+	addonName := "vpc-cni"
+	addonVersion := "v1.12.6-eksbuild.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfigurationDataSourceConfig_basic(addonName, addonVersion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "addon_name", addonName),
+					resource.TestCheckResourceAttr(dataSourceName, "addon_version", addonVersion),
+					resource.TestCheckResourceAttrSet(dataSourceName, "configuration_schema"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddonConfigurationDataSourceConfig_basic(addonName, addonVersion string) string {
+	return fmt.Sprintf(`
+data "aws_eks_addon_configuration" "test" {
+  addon_name    = %[1]q
+  addon_version = %[2]q
+}
+`, addonName, addonVersion)
+}
@@ -23,4 +23,6 @@ func Resources_Values() []string {
 
 const (
 	propagationTimeout = 2 * time.Minute
+
+	fargateProfileConcurrentOperationTimeout = 10 * time.Minute
 )
@@ -124,30 +124,34 @@ func resourceFargateProfileCreate(ctx context.Context, d *schema.ResourceData, m
 		Tags:                getTagsIn(ctx),
 	}
 
-	// mutex lock for creation/deletion serialization
-	mutexKey := fmt.Sprintf("%s-fargate-profiles", clusterName)
+	// EKS creates and deletes the Fargate profiles of a cluster one at a time.
+	// Serialize per cluster so that profiles in different clusters are still created in parallel.
+	mutexKey := fargateProfileMutexKey(clusterName)
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
-		_, err := conn.CreateFargateProfileWithContext(ctx, input)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutCreate))
 
-		// Retry for IAM eventual consistency on error:
-		// InvalidParameterException: Misconfigured PodExecutionRole Trust Policy; Please add the eks-fargate-pods.amazonaws.com Service Principal
-		if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Misconfigured PodExecutionRole Trust Policy") {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+	// Don't retry past the create timeout; whatever remains of it is used to wait for creation.
+	retryTimeout := fargateProfileConcurrentOperationTimeout
+	if v := deadline.Remaining(); v < retryTimeout {
+		retryTimeout = v
+	}
 
-		return nil
-	})
+	// Retry while a Fargate profile operation started outside of this provider instance (e.g. by another configuration) is in progress:
+	// ResourceLimitExceededException: Cannot create Fargate Profile ... because cluster ... currently has Fargate profile ... in status CREATING
+	_, err := tfresource.RetryWhen(ctx, retryTimeout,
+		func() (interface{}, error) {
+			return nil, createFargateProfile(ctx, conn, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, eks.ErrCodeResourceLimitExceededException, "currently has Fargate profile") {
+				return true, err
+			}
 
-	if tfresource.TimedOut(err) {
-		_, err = conn.CreateFargateProfileWithContext(ctx, input)
-	}
+			return false, err
+		},
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EKS Fargate Profile (%s): %s", profileID, err)
@@ -155,7 +159,7 @@ func resourceFargateProfileCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(profileID)
 
-	_, err = waitFargateProfileCreated(ctx, conn, clusterName, fargateProfileName, d.Timeout(schema.TimeoutCreate))
+	_, err = waitFargateProfileCreated(ctx, conn, clusterName, fargateProfileName, deadline.Remaining())
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Fargate Profile (%s) to create: %s", d.Id(), err)
@@ -224,8 +228,7 @@ func resourceFargateProfileDelete(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "deleting EKS Fargate Profile (%s): %s", d.Id(), err)
 	}
 
-	// mutex lock for creation/deletion serialization
-	mutexKey := fmt.Sprintf("%s-fargate-profiles", d.Get("cluster_name").(string))
+	mutexKey := fargateProfileMutexKey(clusterName)
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
@@ -252,6 +255,34 @@ func resourceFargateProfileDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func createFargateProfile(ctx context.Context, conn *eks.EKS, input *eks.CreateFargateProfileInput) error {
+	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+		_, err := conn.CreateFargateProfileWithContext(ctx, input)
+
+		// Retry for IAM eventual consistency on error:
+		// InvalidParameterException: Misconfigured PodExecutionRole Trust Policy; Please add the eks-fargate-pods.amazonaws.com Service Principal
+		if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Misconfigured PodExecutionRole Trust Policy") {
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.CreateFargateProfileWithContext(ctx, input)
+	}
+
+	return err
+}
+
+func fargateProfileMutexKey(clusterName string) string {
+	return fmt.Sprintf("%s-fargate-profiles", clusterName)
+}
+
 func expandFargateProfileSelectors(l []interface{}) []*eks.FargateProfileSelector {
 	if len(l) == 0 {
 		return nil
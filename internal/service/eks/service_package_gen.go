@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceAddon,
 			TypeName: "aws_eks_addon",
 		},
+		{
+			Factory:  DataSourceAddonConfiguration,
+			TypeName: "aws_eks_addon_configuration",
+		},
 		{
 			Factory:  DataSourceAddonVersion,
 			TypeName: "aws_eks_addon_version",
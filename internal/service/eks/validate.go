@@ -4,8 +4,13 @@
 package eks
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -25,3 +30,56 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validateAddonConfigurationValues validates add-on configuration values, in JSON or YAML,
+// against the JSON schema returned by DescribeAddonConfiguration.
+func validateAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	if configurationSchema == "" {
+		return nil
+	}
+
+	var v interface{}
+
+	// YAML is a superset of JSON so a YAML parser handles both formats.
+	if err := yaml.Unmarshal([]byte(configurationValues), &v); err != nil {
+		return fmt.Errorf("parsing: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewGoLoader(normalizeYAMLValue(v)))
+
+	if err != nil {
+		return fmt.Errorf("validating against configuration schema: %w", err)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	var errs []string
+
+	for _, v := range result.Errors() {
+		errs = append(errs, v.String())
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
+
+// normalizeYAMLValue converts the map[interface{}]interface{} values produced by the YAML parser
+// into the map[string]interface{} values expected by the JSON schema validator.
+func normalizeYAMLValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, v := range v {
+			m[fmt.Sprint(k)] = normalizeYAMLValue(v)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeYAMLValue(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
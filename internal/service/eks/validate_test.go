@@ -62,3 +62,69 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAddonConfigurationValues(t *testing.T) {
+	t.Parallel()
+
+	configurationSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "replicaCount": {"type": "integer"},
+    "resources": {
+      "type": "object",
+      "properties": {
+        "limits": {"type": "object", "properties": {"memory": {"type": "string"}}}
+      }
+    }
+  }
+}`
+
+	cases := []struct {
+		Name        string
+		Values      string
+		ExpectError bool
+	}{
+		{
+			Name:   "valid JSON",
+			Values: `{"replicaCount": 2, "resources": {"limits": {"memory": "128Mi"}}}`,
+		},
+		{
+			Name:   "valid YAML",
+			Values: "replicaCount: 2\nresources:\n  limits:\n    memory: 128Mi\n",
+		},
+		{
+			Name:        "wrong type",
+			Values:      `{"replicaCount": "two"}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown property",
+			Values:      `{"replicas": 2}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid document",
+			Values:      `{"replicaCount": `,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAddonConfigurationValues(configurationSchema, tc.Values)
+
+			if tc.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
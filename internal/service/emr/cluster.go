@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -48,7 +49,16 @@ func ResourceCluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// EMR does not allow the master fleet's capacity to be modified.
+			customdiff.ForceNewIfChange("master_instance_fleet.0.target_on_demand_capacity", func(_ context.Context, old, new, meta interface{}) bool {
+				return true
+			}),
+			customdiff.ForceNewIfChange("master_instance_fleet.0.target_spot_capacity", func(_ context.Context, old, new, meta interface{}) bool {
+				return true
+			}),
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"additional_info": {
@@ -749,22 +759,62 @@ func instanceFleetConfigSchema() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"resize_specifications": instanceFleetResizeSpecificationsSchema(),
 			"target_on_demand_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Default:  0,
 			},
 			"target_spot_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Default:  0,
 			},
 		},
 	}
 }
 
+func instanceFleetResizeSpecificationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"on_demand_resize_specification": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timeout_duration_minutes": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(5, 10080),
+							},
+						},
+					},
+				},
+				"spot_resize_specification": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timeout_duration_minutes": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(5, 10080),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRConn(ctx)
@@ -1358,6 +1408,48 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	for _, k := range []string{"core_instance_fleet", "master_instance_fleet"} {
+		if !d.HasChanges(k+".0.resize_specifications", k+".0.target_on_demand_capacity", k+".0.target_spot_capacity") {
+			continue
+		}
+
+		instanceFleetID := d.Get(k + ".0.id").(string)
+
+		if instanceFleetID == "" {
+			continue
+		}
+
+		modifyConfig := &emr.InstanceFleetModifyConfig{
+			InstanceFleetId: aws.String(instanceFleetID),
+		}
+
+		// Capacity is only sent when it changed. Master fleet capacity changes replace the cluster, so for the master
+		// fleet this only applies resize_specifications changes.
+		if d.HasChanges(k+".0.target_on_demand_capacity", k+".0.target_spot_capacity") {
+			modifyConfig.TargetOnDemandCapacity = aws.Int64(int64(d.Get(k + ".0.target_on_demand_capacity").(int)))
+			modifyConfig.TargetSpotCapacity = aws.Int64(int64(d.Get(k + ".0.target_spot_capacity").(int)))
+		}
+
+		if d.HasChange(k + ".0.resize_specifications") {
+			if v, ok := d.GetOk(k + ".0.resize_specifications"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				modifyConfig.ResizeSpecifications = expandInstanceFleetResizeSpecifications(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		input := &emr.ModifyInstanceFleetInput{
+			ClusterId:     aws.String(d.Id()),
+			InstanceFleet: modifyConfig,
+		}
+
+		if _, err := conn.ModifyInstanceFleetWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EMR Cluster (%s) Instance Fleet (%s): %s", d.Id(), instanceFleetID, err)
+		}
+
+		if _, err := waitInstanceFleetRunning(ctx, conn, d.Id(), instanceFleetID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Fleet (%s) modification: %s", d.Id(), instanceFleetID, err)
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
@@ -1992,6 +2084,10 @@ func readInstanceFleetConfig(data map[string]interface{}, InstanceFleetType stri
 		config.LaunchSpecifications = expandLaunchSpecification(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := data["resize_specifications"].([]interface{}); ok && len(v) == 1 && v[0] != nil {
+		config.ResizeSpecifications = expandInstanceFleetResizeSpecifications(v[0].(map[string]interface{}))
+	}
+
 	return config
 }
 
@@ -2035,6 +2131,7 @@ func flattenInstanceFleet(instanceFleet *emr.InstanceFleet) []interface{} {
 		"provisioned_spot_capacity":      aws.Int64Value(instanceFleet.ProvisionedSpotCapacity),
 		"instance_type_configs":          flatteninstanceTypeConfigs(instanceFleet.InstanceTypeSpecifications),
 		"launch_specifications":          flattenLaunchSpecifications(instanceFleet.LaunchSpecifications),
+		"resize_specifications":          flattenInstanceFleetResizeSpecifications(instanceFleet.ResizeSpecifications),
 	}
 
 	return []interface{}{m}
@@ -2202,6 +2299,50 @@ func expandLaunchSpecification(launchSpecification map[string]interface{}) *emr.
 	return fleetSpecification
 }
 
+func expandInstanceFleetResizeSpecifications(tfMap map[string]interface{}) *emr.InstanceFleetResizingSpecifications {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emr.InstanceFleetResizingSpecifications{}
+
+	if v, ok := tfMap["on_demand_resize_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnDemandResizeSpecification = &emr.OnDemandResizingSpecification{
+			TimeoutDurationMinutes: aws.Int64(int64(v[0].(map[string]interface{})["timeout_duration_minutes"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["spot_resize_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpotResizeSpecification = &emr.SpotResizingSpecification{
+			TimeoutDurationMinutes: aws.Int64(int64(v[0].(map[string]interface{})["timeout_duration_minutes"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenInstanceFleetResizeSpecifications(apiObject *emr.InstanceFleetResizingSpecifications) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OnDemandResizeSpecification; v != nil {
+		tfMap["on_demand_resize_specification"] = []interface{}{map[string]interface{}{
+			"timeout_duration_minutes": aws.Int64Value(v.TimeoutDurationMinutes),
+		}}
+	}
+
+	if v := apiObject.SpotResizeSpecification; v != nil {
+		tfMap["spot_resize_specification"] = []interface{}{map[string]interface{}{
+			"timeout_duration_minutes": aws.Int64Value(v.TimeoutDurationMinutes),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandConfigurations(configurations []interface{}) []*emr.Configuration {
 	configsOut := []*emr.Configuration{}
 
@@ -1663,6 +1663,78 @@ func TestAccEMRCluster_InstanceFleet_basic(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_InstanceFleet_resize(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_instanceFleetsResize(rName, 1, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.resize_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.resize_specifications.0.on_demand_resize_specification.0.timeout_duration_minutes", "20"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_instanceFleetsResize(rName, 2, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.resize_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.resize_specifications.0.on_demand_resize_specification.0.timeout_duration_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRCluster_InstanceFleetMaster_capacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_instanceFleetsMasterCapacity(rName, 1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.0.target_on_demand_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.0.target_spot_capacity", "0"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_instanceFleetsMasterCapacity(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.0.target_on_demand_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.0.target_spot_capacity", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_InstanceFleetMaster_only(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster emr.Cluster
@@ -3925,6 +3997,58 @@ resource "aws_emr_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_instanceFleetsResize(rName string, coreCapacity, timeout int) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.30.1"
+  applications  = ["Hadoop", "Hive"]
+
+  master_instance_fleet {
+    instance_type_configs {
+      instance_type = "m4.xlarge"
+    }
+
+    target_on_demand_capacity = 1
+  }
+
+  core_instance_fleet {
+    instance_type_configs {
+      instance_type     = "m4.xlarge"
+      weighted_capacity = 1
+    }
+
+    resize_specifications {
+      on_demand_resize_specification {
+        timeout_duration_minutes = %[3]d
+      }
+    }
+
+    name                      = "core fleet"
+    target_on_demand_capacity = %[2]d
+  }
+
+  service_role = aws_iam_role.emr_service.arn
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+}
+`, rName, coreCapacity, timeout))
+}
+
 func testAccClusterConfig_instanceFleetMultipleSubnets(rName string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
@@ -4026,6 +4150,10 @@ resource "aws_route_table_association" "test2" {
 }
 
 func testAccClusterConfig_instanceFleetsMasterOnly(rName string) string {
+	return testAccClusterConfig_instanceFleetsMasterCapacity(rName, 1, 0)
+}
+
+func testAccClusterConfig_instanceFleetsMasterCapacity(rName string, onDemand, spot int) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
 		testAccClusterConfig_baseIAMServiceRole(rName),
@@ -4045,7 +4173,8 @@ resource "aws_emr_cluster" "test" {
       instance_type = "m3.xlarge"
     }
 
-    target_on_demand_capacity = 1
+    target_on_demand_capacity = %[2]d
+    target_spot_capacity      = %[3]d
   }
   service_role = aws_iam_role.emr_service.arn
   depends_on = [
@@ -4067,7 +4196,7 @@ resource "aws_emr_cluster" "test" {
     args = ["instance.isMaster=true", "echo running on master node"]
   }
 }
-`, rName))
+`, rName, onDemand, spot))
 }
 
 func testAccClusterConfig_autoTermination(rName string, timeout int) string {
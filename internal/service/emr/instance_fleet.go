@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emr"
@@ -207,6 +206,7 @@ func ResourceInstanceFleet() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"resize_specifications": instanceFleetResizeSpecificationsSchema(),
 			"target_on_demand_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -231,6 +231,7 @@ func resourceInstanceFleetCreate(ctx context.Context, d *schema.ResourceData, me
 		"target_spot_capacity":      d.Get("target_spot_capacity"),
 		"instance_type_configs":     d.Get("instance_type_configs"),
 		"launch_specifications":     d.Get("launch_specifications"),
+		"resize_specifications":     d.Get("resize_specifications"),
 	}
 	input := &emr.AddInstanceFleetInput{
 		ClusterId:     aws.String(d.Get("cluster_id").(string)),
@@ -273,6 +274,9 @@ func resourceInstanceFleetRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("name", fleet.Name)
 	d.Set("provisioned_on_demand_capacity", fleet.ProvisionedOnDemandCapacity)
 	d.Set("provisioned_spot_capacity", fleet.ProvisionedSpotCapacity)
+	if err := d.Set("resize_specifications", flattenInstanceFleetResizeSpecifications(fleet.ResizeSpecifications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resize_specifications: %s", err)
+	}
 	d.Set("target_on_demand_capacity", fleet.TargetOnDemandCapacity)
 	d.Set("target_spot_capacity", fleet.TargetSpotCapacity)
 
@@ -288,6 +292,13 @@ func resourceInstanceFleetUpdate(ctx context.Context, d *schema.ResourceData, me
 		TargetOnDemandCapacity: aws.Int64(int64(d.Get("target_on_demand_capacity").(int))),
 		TargetSpotCapacity:     aws.Int64(int64(d.Get("target_spot_capacity").(int))),
 	}
+
+	if d.HasChange("resize_specifications") {
+		if v, ok := d.GetOk("resize_specifications"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			modifyConfig.ResizeSpecifications = expandInstanceFleetResizeSpecifications(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	input := &emr.ModifyInstanceFleetInput{
 		ClusterId:     aws.String(d.Get("cluster_id").(string)),
 		InstanceFleet: modifyConfig,
@@ -299,18 +310,7 @@ func resourceInstanceFleetUpdate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "updating EMR Instance Fleet (%s): %s", d.Id(), err)
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{emr.InstanceFleetStateProvisioning, emr.InstanceFleetStateBootstrapping, emr.InstanceFleetStateResizing},
-		Target:     []string{emr.InstanceFleetStateRunning},
-		Refresh:    statusInstanceFleet(ctx, conn, d.Get("cluster_id").(string), d.Id()),
-		Timeout:    75 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 30 * time.Second,
-	}
-
-	_, err = stateConf.WaitForStateContext(ctx)
-
-	if err != nil {
+	if _, err := waitInstanceFleetRunning(ctx, conn, d.Get("cluster_id").(string), d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EMR Instance Fleet (%s) update: %s", d.Id(), err)
 	}
 
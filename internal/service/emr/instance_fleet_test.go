@@ -145,6 +145,48 @@ func TestAccEMRInstanceFleet_full(t *testing.T) {
 	})
 }
 
+func TestAccEMRInstanceFleet_resizeSpecifications(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet emr.InstanceFleet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emr_instance_fleet.task"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceFleetConfig_resizeSpecifications(rName, 1, 20),
+				Check: resource.ComposeTestCheckFunc(testAccCheckInstanceFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.0.on_demand_resize_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.0.on_demand_resize_specification.0.timeout_duration_minutes", "20"),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.0.spot_resize_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.0.spot_resize_specification.0.timeout_duration_minutes", "20"),
+					resource.TestCheckResourceAttr(resourceName, "target_on_demand_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccInstanceFleetResourceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceFleetConfig_resizeSpecifications(rName, 2, 30),
+				Check: resource.ComposeTestCheckFunc(testAccCheckInstanceFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.0.on_demand_resize_specification.0.timeout_duration_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "resize_specifications.0.spot_resize_specification.0.timeout_duration_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "target_on_demand_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceFleetExists(ctx context.Context, n string, v *emr.InstanceFleet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -527,3 +569,30 @@ resource "aws_emr_instance_fleet" "task" {
 }
 `, rName))
 }
+
+func testAccInstanceFleetConfig_resizeSpecifications(rName string, onDemandCapacity, timeout int) string {
+	return acctest.ConfigCompose(testAccInstanceFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_emr_instance_fleet" "task" {
+  cluster_id = aws_emr_cluster.test.id
+
+  instance_type_configs {
+    instance_type     = "m4.xlarge"
+    weighted_capacity = 1
+  }
+
+  resize_specifications {
+    on_demand_resize_specification {
+      timeout_duration_minutes = %[3]d
+    }
+
+    spot_resize_specification {
+      timeout_duration_minutes = %[3]d
+    }
+  }
+
+  name                      = "emr_instance_fleet_%[1]s"
+  target_on_demand_capacity = %[2]d
+  target_spot_capacity      = 0
+}
+`, rName, onDemandCapacity, timeout))
+}
@@ -22,6 +22,10 @@ const (
 	ClusterDeletedTimeout    = 20 * time.Minute
 	ClusterDeletedMinTimeout = 10 * time.Second
 	ClusterDeletedDelay      = 30 * time.Second
+
+	InstanceFleetRunningTimeout    = 75 * time.Minute
+	InstanceFleetRunningMinTimeout = 30 * time.Second
+	InstanceFleetRunningDelay      = 10 * time.Second
 )
 
 func waitClusterCreated(ctx context.Context, conn *emr.EMR, id string) (*emr.Cluster, error) {
@@ -69,3 +73,26 @@ func waitClusterDeleted(ctx context.Context, conn *emr.EMR, id string) (*emr.Clu
 
 	return nil, err
 }
+
+func waitInstanceFleetRunning(ctx context.Context, conn *emr.EMR, clusterID, fleetID string) (*emr.InstanceFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{emr.InstanceFleetStateProvisioning, emr.InstanceFleetStateBootstrapping, emr.InstanceFleetStateResizing},
+		Target:     []string{emr.InstanceFleetStateRunning},
+		Refresh:    statusInstanceFleet(ctx, conn, clusterID, fleetID),
+		Timeout:    InstanceFleetRunningTimeout,
+		MinTimeout: InstanceFleetRunningMinTimeout,
+		Delay:      InstanceFleetRunningDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emr.InstanceFleet); ok {
+		if stateChangeReason := output.Status.StateChangeReason; stateChangeReason != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateChangeReason.Code), aws.StringValue(stateChangeReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
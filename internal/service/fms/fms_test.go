@@ -22,9 +22,15 @@ func TestAccFMS_serial(t *testing.T) {
 			"includeMap":             testAccPolicy_includeMap,
 			"update":                 testAccPolicy_update,
 			"policyOption":           testAccPolicy_policyOption,
+			"resourceSetIDs":         testAccPolicy_resourceSetIDs,
 			"resourceTags":           testAccPolicy_resourceTags,
 			"tags":                   testAccPolicy_tags,
 		},
+		"ResourceSet": {
+			"basic":      testAccResourceSet_basic,
+			"disappears": testAccResourceSet_disappears,
+			"tags":       testAccResourceSet_tags,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -126,6 +126,13 @@ func ResourcePolicy() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"resource_set_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"resource_tags": tftags.TagsSchema(),
 			"resource_type": {
 				Type:          schema.TypeString,
@@ -193,8 +200,9 @@ func ResourcePolicy() *schema.Resource {
 							},
 						},
 						"type": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
 						},
 					},
 				},
@@ -256,6 +264,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("name", policy.PolicyName)
 	d.Set("policy_update_token", policy.PolicyUpdateToken)
 	d.Set("remediation_enabled", policy.RemediationEnabled)
+	d.Set("resource_set_ids", aws.StringValueSlice(policy.ResourceSetIds))
 	if err := d.Set("resource_tags", flattenResourceTags(policy.ResourceTags)); err != nil {
 		sdkdiag.AppendErrorf(diags, "setting resource_tags: %s", err)
 	}
@@ -353,6 +362,7 @@ func resourcePolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
 		PolicyDescription:              aws.String(d.Get("description").(string)),
 		PolicyName:                     aws.String(d.Get("name").(string)),
 		RemediationEnabled:             aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceSetIds:                 flex.ExpandStringSet(d.Get("resource_set_ids").(*schema.Set)),
 		ResourceType:                   resourceType,
 		ResourceTypeList:               resourceTypeList,
 	}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fms
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_fms_resource_set", name="Resource Set")
+// @Tags(identifierAttribute="arn")
+func ResourceResourceSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceSetCreate,
+		ReadWithoutTimeout:   resourceResourceSetRead,
+		UpdateWithoutTimeout: resourceResourceSetUpdate,
+		DeleteWithoutTimeout: resourceResourceSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"resource_set_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`), "must match a supported resource type, such as AWS::EC2::VPC, see also: https://docs.aws.amazon.com/fms/2018-01-01/APIReference/API_ResourceSet.html"),
+				},
+			},
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceResourceSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FMSConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &fms.PutResourceSetInput{
+		ResourceSet: &fms.ResourceSet{
+			Name:             aws.String(name),
+			ResourceTypeList: flex.ExpandStringSet(d.Get("resource_type_list").(*schema.Set)),
+		},
+		TagList: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ResourceSet.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.PutResourceSetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating FMS Resource Set (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ResourceSet.Id))
+
+	return append(diags, resourceResourceSetRead(ctx, d, meta)...)
+}
+
+func resourceResourceSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FMSConn(ctx)
+
+	output, err := FindResourceSetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FMS Resource Set %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FMS Resource Set (%s): %s", d.Id(), err)
+	}
+
+	resourceSet := output.ResourceSet
+	d.Set("arn", output.ResourceSetArn)
+	d.Set("description", resourceSet.Description)
+	if v := resourceSet.LastUpdateTime; v != nil {
+		d.Set("last_update_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("last_update_time", nil)
+	}
+	d.Set("name", resourceSet.Name)
+	d.Set("resource_set_status", resourceSet.ResourceSetStatus)
+	d.Set("resource_type_list", aws.StringValueSlice(resourceSet.ResourceTypeList))
+	d.Set("update_token", resourceSet.UpdateToken)
+
+	return diags
+}
+
+func resourceResourceSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FMSConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &fms.PutResourceSetInput{
+			ResourceSet: &fms.ResourceSet{
+				Description:      aws.String(d.Get("description").(string)),
+				Id:               aws.String(d.Id()),
+				Name:             aws.String(d.Get("name").(string)),
+				ResourceTypeList: flex.ExpandStringSet(d.Get("resource_type_list").(*schema.Set)),
+				UpdateToken:      aws.String(d.Get("update_token").(string)),
+			},
+		}
+
+		_, err := conn.PutResourceSetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating FMS Resource Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceSetRead(ctx, d, meta)...)
+}
+
+func resourceResourceSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FMSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting FMS Resource Set: %s", d.Id())
+	_, err := conn.DeleteResourceSetWithContext(ctx, &fms.DeleteResourceSetInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting FMS Resource Set (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindResourceSetByID(ctx context.Context, conn *fms.FMS, id string) (*fms.GetResourceSetOutput, error) {
+	input := &fms.GetResourceSetInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetResourceSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ResourceSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
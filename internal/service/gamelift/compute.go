@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	computeResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_gamelift_compute", name="Compute")
+func ResourceCompute() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComputeCreate,
+		ReadWithoutTimeout:   resourceComputeRead,
+		DeleteWithoutTimeout: resourceComputeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"compute_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"compute_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+				ExactlyOneOf: []string{"dns_name", "ip_address"},
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"game_lift_service_sdk_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{"dns_name", "ip_address"},
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	fleetID := d.Get("fleet_id").(string)
+	computeName := d.Get("compute_name").(string)
+	id, err := flex.FlattenResourceId([]string{fleetID, computeName}, computeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &gamelift.RegisterComputeInput{
+		ComputeName: aws.String(computeName),
+		FleetId:     aws.String(fleetID),
+	}
+
+	if v, ok := d.GetOk("certificate_path"); ok {
+		input.CertificatePath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dns_name"); ok {
+		input.DnsName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ip_address"); ok {
+		input.IpAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok {
+		input.Location = aws.String(v.(string))
+	}
+
+	_, err = conn.RegisterComputeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "registering GameLift Compute (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceComputeRead(ctx, d, meta)...)
+}
+
+func resourceComputeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), computeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	fleetID, computeName := parts[0], parts[1]
+	compute, err := FindComputeByTwoPartKey(ctx, conn, fleetID, computeName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Compute (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Compute (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", compute.ComputeArn)
+	d.Set("compute_name", compute.ComputeName)
+	d.Set("compute_status", compute.ComputeStatus)
+	d.Set("dns_name", compute.DnsName)
+	d.Set("fleet_id", compute.FleetId)
+	d.Set("game_lift_service_sdk_endpoint", compute.GameLiftServiceSdkEndpoint)
+	d.Set("ip_address", compute.IpAddress)
+	d.Set("location", compute.Location)
+	d.Set("operating_system", compute.OperatingSystem)
+
+	return diags
+}
+
+func resourceComputeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), computeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deregistering GameLift Compute: %s", d.Id())
+	_, err = conn.DeregisterComputeWithContext(ctx, &gamelift.DeregisterComputeInput{
+		ComputeName: aws.String(parts[1]),
+		FleetId:     aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering GameLift Compute (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_gamelift_compute_auth_token")
+func DataSourceComputeAuthToken() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComputeAuthTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"compute_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expiration_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeAuthTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	fleetID := d.Get("fleet_id").(string)
+	computeName := d.Get("compute_name").(string)
+	id, err := flex.FlattenResourceId([]string{fleetID, computeName}, computeResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := conn.GetComputeAuthTokenWithContext(ctx, &gamelift.GetComputeAuthTokenInput{
+		ComputeName: aws.String(computeName),
+		FleetId:     aws.String(fleetID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Compute (%s) auth token: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	d.Set("auth_token", output.AuthToken)
+	d.Set("compute_arn", output.ComputeArn)
+	d.Set("compute_name", output.ComputeName)
+	d.Set("expiration_timestamp", aws.TimeValue(output.ExpirationTimestamp).Format(time.RFC3339))
+	d.Set("fleet_arn", output.FleetArn)
+	d.Set("fleet_id", output.FleetId)
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGameLiftComputeAuthTokenDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := "custom-" + sdkacctest.RandString(10)
+	dataSourceName := "data.aws_gamelift_compute_auth_token.test"
+	resourceName := "aws_gamelift_compute.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeAuthTokenDataSourceConfig_basic(rName, locationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "auth_token"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "compute_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "compute_name", resourceName, "compute_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiration_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_arn", "aws_gamelift_fleet.test", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_id", resourceName, "fleet_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeAuthTokenDataSourceConfig_basic(rName, locationName string) string {
+	return acctest.ConfigCompose(testAccComputeConfig_basic(rName, locationName), `
+data "aws_gamelift_compute_auth_token" "test" {
+  compute_name = aws_gamelift_compute.test.compute_name
+  fleet_id     = aws_gamelift_compute.test.fleet_id
+}
+`)
+}
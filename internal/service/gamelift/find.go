@@ -38,6 +38,32 @@ func FindBuildByID(ctx context.Context, conn *gamelift.GameLift, id string) (*ga
 	return output.Build, nil
 }
 
+func FindComputeByTwoPartKey(ctx context.Context, conn *gamelift.GameLift, fleetID, computeName string) (*gamelift.Compute, error) {
+	input := &gamelift.DescribeComputeInput{
+		ComputeName: aws.String(computeName),
+		FleetId:     aws.String(fleetID),
+	}
+
+	output, err := conn.DescribeComputeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Compute == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Compute, nil
+}
+
 func FindFleetByID(ctx context.Context, conn *gamelift.GameLift, id string) (*gamelift.FleetAttributes, error) {
 	input := &gamelift.DescribeFleetAttributesInput{
 		FleetIds: aws.StringSlice([]string{id}),
@@ -98,6 +124,41 @@ func FindGameServerGroupByName(ctx context.Context, conn *gamelift.GameLift, nam
 	return output.GameServerGroup, nil
 }
 
+func FindLocationByName(ctx context.Context, conn *gamelift.GameLift, name string) (*gamelift.LocationModel, error) {
+	input := &gamelift.ListLocationsInput{
+		Filters: aws.StringSlice([]string{gamelift.LocationFilterCustom}),
+	}
+	var output *gamelift.LocationModel
+
+	err := conn.ListLocationsPagesWithContext(ctx, input, func(page *gamelift.ListLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Locations {
+			if v != nil && aws.StringValue(v.LocationName) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindScriptByID(ctx context.Context, conn *gamelift.GameLift, id string) (*gamelift.Script, error) {
 	input := &gamelift.DescribeScriptInput{
 		ScriptId: aws.String(id),
@@ -49,6 +49,20 @@ func ResourceFleet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"anywhere_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 11),
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,10 +72,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"build_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"script_id"},
 			},
 			"certificate_configuration": {
 				Type:     schema.TypeList,
@@ -80,6 +94,13 @@ func ResourceFleet() *schema.Resource {
 					},
 				},
 			},
+			"compute_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ComputeType_Values(), false),
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,7 +138,7 @@ func ResourceFleet() *schema.Resource {
 			},
 			"ec2_instance_type": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(gamelift.EC2InstanceType_Values(), false),
 			},
@@ -134,6 +155,15 @@ func ResourceFleet() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				Optional:     true,
 			},
+			"locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
 			"log_paths": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -230,10 +260,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"script_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"build_id"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -248,9 +278,12 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
 
 	input := &gamelift.CreateFleetInput{
-		EC2InstanceType: aws.String(d.Get("ec2_instance_type").(string)),
-		Name:            aws.String(d.Get("name").(string)),
-		Tags:            getTagsIn(ctx),
+		Name: aws.String(d.Get("name").(string)),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("anywhere_configuration"); ok {
+		input.AnywhereConfiguration = expandAnywhereConfiguration(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("build_id"); ok {
@@ -261,9 +294,16 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ScriptId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("compute_type"); ok {
+		input.ComputeType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("ec2_instance_type"); ok {
+		input.EC2InstanceType = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("fleet_type"); ok {
 		input.FleetType = aws.String(v.(string))
 	}
@@ -275,6 +315,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("locations"); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("metric_groups"); ok {
 		input.MetricGroups = flex.ExpandStringList(v.([]interface{}))
 	}
@@ -343,8 +387,12 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	arn := aws.StringValue(fleet.FleetArn)
+	if err := d.Set("anywhere_configuration", flattenAnywhereConfiguration(fleet.AnywhereConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting anywhere_configuration: %s", err)
+	}
 	d.Set("build_arn", fleet.BuildArn)
 	d.Set("build_id", fleet.BuildId)
+	d.Set("compute_type", fleet.ComputeType)
 	d.Set("description", fleet.Description)
 	d.Set("arn", arn)
 	d.Set("log_paths", aws.StringValueSlice(fleet.LogPaths))
@@ -366,6 +414,11 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting resource_creation_limit_policy: %s", err)
 	}
 
+	// Anywhere fleets have no EC2 port settings.
+	if aws.StringValue(fleet.ComputeType) == gamelift.ComputeTypeAnywhere {
+		return diags
+	}
+
 	portInput := &gamelift.DescribeFleetPortSettingsInput{
 		FleetId: aws.String(d.Id()),
 	}
@@ -388,15 +441,21 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Updating GameLift Fleet: %s", d.Id())
 
-	if d.HasChanges("description", "metric_groups", "name", "new_game_session_protection_policy", "resource_creation_limit_policy") {
-		_, err := conn.UpdateFleetAttributesWithContext(ctx, &gamelift.UpdateFleetAttributesInput{
+	if d.HasChanges("anywhere_configuration", "description", "metric_groups", "name", "new_game_session_protection_policy", "resource_creation_limit_policy") {
+		input := &gamelift.UpdateFleetAttributesInput{
 			Description:                    aws.String(d.Get("description").(string)),
 			FleetId:                        aws.String(d.Id()),
 			MetricGroups:                   flex.ExpandStringList(d.Get("metric_groups").([]interface{})),
 			Name:                           aws.String(d.Get("name").(string)),
 			NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
 			ResourceCreationLimitPolicy:    expandResourceCreationLimitPolicy(d.Get("resource_creation_limit_policy").([]interface{})),
-		})
+		}
+
+		if d.HasChange("anywhere_configuration") {
+			input.AnywhereConfiguration = expandAnywhereConfiguration(d.Get("anywhere_configuration").([]interface{}))
+		}
+
+		_, err := conn.UpdateFleetAttributesWithContext(ctx, input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating for GameLift Fleet attributes (%s): %s", d.Id(), err)
 		}
@@ -467,6 +526,41 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func expandAnywhereConfiguration(cfg []interface{}) *gamelift.AnywhereConfiguration {
+	if len(cfg) < 1 || cfg[0] == nil {
+		return nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+
+	return &gamelift.AnywhereConfiguration{
+		Cost: aws.String(m["cost"].(string)),
+	}
+}
+
+func flattenAnywhereConfiguration(config *gamelift.AnywhereConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	m["cost"] = aws.StringValue(config.Cost)
+
+	return []interface{}{m}
+}
+
+func expandLocationConfigurations(tfList []interface{}) []*gamelift.LocationConfiguration {
+	apiObjects := make([]*gamelift.LocationConfiguration, 0, len(tfList))
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, &gamelift.LocationConfiguration{
+			Location: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandIPPermissions(cfgs *schema.Set) []*gamelift.IpPermission {
 	if cfgs.Len() < 1 {
 		return []*gamelift.IpPermission{}
@@ -584,6 +584,56 @@ func TestAccGameLiftFleet_script(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftFleet_anywhere(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf gamelift.FleetAttributes
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := "custom-" + sdkacctest.RandString(10)
+	resourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_anywhere(rName, locationName, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "10"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "gamelift", regexp.MustCompile(`fleet/fleet-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_type", gamelift.ComputeTypeAnywhere),
+					resource.TestCheckResourceAttr(resourceName, "ec2_instance_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "locations.*", locationName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"locations"},
+			},
+			{
+				Config: testAccFleetConfig_anywhere(rName, locationName, "20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "20"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGameLiftFleet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1001,3 +1051,21 @@ resource "aws_gamelift_fleet" "test" {
 }
 `, rName)
 }
+
+func testAccFleetConfig_anywhere(rName, locationName, cost string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_location" "test" {
+  location_name = %[2]q
+}
+
+resource "aws_gamelift_fleet" "test" {
+  compute_type = "ANYWHERE"
+  locations    = [aws_gamelift_location.test.location_name]
+  name         = %[1]q
+
+  anywhere_configuration {
+    cost = %[3]q
+  }
+}
+`, rName, locationName, cost)
+}
@@ -24,7 +24,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceComputeAuthToken,
+			TypeName: "aws_gamelift_compute_auth_token",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -45,6 +50,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceCompute,
+			TypeName: "aws_gamelift_compute",
+			Name:     "Compute",
+		},
 		{
 			Factory:  ResourceFleet,
 			TypeName: "aws_gamelift_fleet",
@@ -69,6 +79,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceLocation,
+			TypeName: "aws_gamelift_location",
+			Name:     "Location",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceScript,
 			TypeName: "aws_gamelift_script",
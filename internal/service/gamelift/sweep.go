@@ -45,6 +45,14 @@ func init() {
 		F: sweepFleets,
 	})
 
+	resource.AddTestSweepers("aws_gamelift_location", &resource.Sweeper{
+		Name: "aws_gamelift_location",
+		Dependencies: []string{
+			"aws_gamelift_fleet",
+		},
+		F: sweepLocations,
+	})
+
 	resource.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
 		Name: "aws_gamelift_game_server_group",
 		F:    sweepGameServerGroups,
@@ -226,6 +234,52 @@ func sweepFleets(region string) error {
 	return errs.ErrorOrNil()
 }
 
+func sweepLocations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.GameLiftConn(ctx)
+	input := &gamelift.ListLocationsInput{
+		Filters: aws.StringSlice([]string{gamelift.LocationFilterCustom}),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListLocationsPagesWithContext(ctx, input, func(page *gamelift.ListLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Locations {
+			r := ResourceLocation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.LocationName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping GameLift Location sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing GameLift Locations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Locations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepGameServerGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
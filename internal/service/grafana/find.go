@@ -84,6 +84,37 @@ func FindSamlConfigurationByID(ctx context.Context, conn *managedgrafana.Managed
 	return output.Authentication.Saml, nil
 }
 
+// FindUpgradeVersionsByWorkspaceID returns the Grafana versions to which the specified workspace can be upgraded.
+func FindUpgradeVersionsByWorkspaceID(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) ([]string, error) {
+	input := &managedgrafana.ListVersionsInput{
+		WorkspaceId: aws.String(id),
+	}
+	var output []string
+
+	err := conn.ListVersionsPagesWithContext(ctx, input, func(page *managedgrafana.ListVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.GrafanaVersions)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindRoleAssociationsByRoleAndWorkspaceID(ctx context.Context, conn *managedgrafana.ManagedGrafana, role string, workspaceID string) (map[string][]string, error) {
 	input := &managedgrafana.ListPermissionsInput{
 		WorkspaceId: aws.String(workspaceID),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -183,7 +185,10 @@ func ResourceWorkspace() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceWorkspaceCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -453,6 +458,35 @@ func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceWorkspaceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only validate in-place upgrades of existing workspaces.
+	if d.Id() == "" || !d.HasChange("grafana_version") {
+		return nil
+	}
+
+	o, n := d.GetChange("grafana_version")
+	if o.(string) == "" || n.(string) == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	versions, err := FindUpgradeVersionsByWorkspaceID(ctx, conn, d.Id())
+
+	if err != nil {
+		log.Printf("[WARN] Unable to validate Grafana Workspace (%s) grafana_version: listing upgrade versions: %s", d.Id(), err)
+		return nil
+	}
+
+	for _, v := range versions {
+		if v == n.(string) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Grafana Workspace (%s) cannot be upgraded from version %s to %s; available versions: [%s]", d.Id(), o, n, strings.Join(versions, ", "))
+}
+
 func expandVPCConfiguration(cfg []interface{}) *managedgrafana.VpcConfiguration {
 	if len(cfg) < 1 {
 		return nil
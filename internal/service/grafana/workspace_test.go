@@ -550,6 +550,10 @@ func testAccWorkspace_version(t *testing.T) {
 					testAccCheckWorkspaceNotRecreated(&v2, &v1),
 				),
 			},
+			{
+				Config:      testAccWorkspaceConfig_version(rName, "8.4"),
+				ExpectError: regexp.MustCompile(`cannot be upgraded from version 9.4 to 8.4`),
+			},
 		},
 	})
 }
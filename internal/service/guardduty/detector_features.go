@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_guardduty_detector_features")
+func ResourceDetectorFeatures() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorFeaturesPut,
+		ReadWithoutTimeout:   resourceDetectorFeaturesRead,
+		UpdateWithoutTimeout: resourceDetectorFeaturesPut,
+		DeleteWithoutTimeout: resourceDetectorFeaturesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"feature": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+									},
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.DetectorFeature_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDetectorFeaturesPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID := d.Get("detector_id").(string)
+	// All features are sent in a single request so that they are applied atomically.
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features:   expandDetectorFeatureConfigurations(d.Get("feature").(*schema.Set).List()),
+	}
+
+	_, err := conn.UpdateDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Detector (%s) features: %s", detectorID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(detectorID)
+	}
+
+	return append(diags, resourceDetectorFeaturesRead(ctx, d, meta)...)
+}
+
+func resourceDetectorFeaturesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	output, err := FindDetectorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Detector (%s) not found, removing features from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector (%s) features: %s", d.Id(), err)
+	}
+
+	d.Set("detector_id", d.Id())
+	if err := d.Set("feature", flattenDetectorFeatureConfigurationResults(output.Features, managedDetectorFeatures(d.Get("feature").(*schema.Set).List()))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting feature: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDetectorFeaturesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] GuardDuty Detector (%s) features are left in their current state; removing from Terraform state only", d.Id())
+
+	return nil
+}
+
+// managedDetectorFeatures returns the names of the features, and their additional configurations, managed by the resource.
+// A nil result means that all features are managed, e.g. on import.
+func managedDetectorFeatures(tfList []interface{}) map[string]map[string]struct{} {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	features := make(map[string]map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		additionalConfigurations := make(map[string]struct{})
+
+		if v, ok := tfMap["additional_configuration"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+					additionalConfigurations[tfMap["name"].(string)] = struct{}{}
+				}
+			}
+		}
+
+		features[tfMap["name"].(string)] = additionalConfigurations
+	}
+
+	return features
+}
+
+func expandDetectorFeatureConfigurations(tfList []interface{}) []*guardduty.DetectorFeatureConfiguration {
+	var apiObjects []*guardduty.DetectorFeatureConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.DetectorFeatureConfiguration{
+			Name:   aws.String(tfMap["name"].(string)),
+			Status: aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["additional_configuration"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.AdditionalConfiguration = append(apiObject.AdditionalConfiguration, &guardduty.DetectorAdditionalConfiguration{
+					Name:   aws.String(tfMap["name"].(string)),
+					Status: aws.String(tfMap["status"].(string)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorFeatureConfigurationResults(apiObjects []*guardduty.DetectorFeatureConfigurationResult, managed map[string]map[string]struct{}) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		name := aws.StringValue(apiObject.Name)
+
+		// Features that can only be read, e.g. CLOUD_TRAIL, aren't valid in configuration.
+		if !validDetectorFeature(name) {
+			continue
+		}
+
+		var managedAdditionalConfigurations map[string]struct{}
+
+		if managed != nil {
+			v, ok := managed[name]
+
+			if !ok {
+				continue
+			}
+
+			managedAdditionalConfigurations = v
+		}
+
+		var additionalConfigurations []interface{}
+
+		for _, apiObject := range apiObject.AdditionalConfiguration {
+			if apiObject == nil {
+				continue
+			}
+
+			name := aws.StringValue(apiObject.Name)
+
+			if managed != nil {
+				if _, ok := managedAdditionalConfigurations[name]; !ok {
+					continue
+				}
+			}
+
+			additionalConfigurations = append(additionalConfigurations, map[string]interface{}{
+				"name":   name,
+				"status": aws.StringValue(apiObject.Status),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"additional_configuration": additionalConfigurations,
+			"name":                     name,
+			"status":                   aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
+
+func validDetectorFeature(name string) bool {
+	for _, v := range guardduty.DetectorFeature_Values() {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
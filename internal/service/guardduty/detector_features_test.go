@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccDetectorFeatures_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_detector_features.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeaturesConfig_basic("ENABLED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":   "S3_DATA_EVENTS",
+						"status": "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":   "RDS_LOGIN_EVENTS",
+						"status": "DISABLED",
+					}),
+				),
+			},
+			{
+				Config: testAccDetectorFeaturesConfig_basic("DISABLED", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":   "S3_DATA_EVENTS",
+						"status": "DISABLED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":   "RDS_LOGIN_EVENTS",
+						"status": "ENABLED",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeatures_additionalConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_detector_features.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeaturesConfig_additionalConfiguration("ENABLED", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "feature.0.name", "EKS_RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, "feature.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "feature.0.additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "feature.0.additional_configuration.0.name", "EKS_ADDON_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "feature.0.additional_configuration.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Features not managed by the resource are only read on import.
+				ImportStateVerifyIgnore: []string{"feature"},
+			},
+			{
+				Config: testAccDetectorFeaturesConfig_additionalConfiguration("ENABLED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeaturesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "feature.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "feature.0.additional_configuration.0.status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorFeaturesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Detector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		_, err := tfguardduty.FindDetectorByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDetectorFeaturesConfig_basic(s3Status, rdsStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_detector_features" "test" {
+  detector_id = aws_guardduty_detector.test.id
+
+  feature {
+    name   = "S3_DATA_EVENTS"
+    status = %[1]q
+  }
+
+  feature {
+    name   = "RDS_LOGIN_EVENTS"
+    status = %[2]q
+  }
+}
+`, s3Status, rdsStatus)
+}
+
+func testAccDetectorFeaturesConfig_additionalConfiguration(featureStatus, additionalStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_detector_features" "test" {
+  detector_id = aws_guardduty_detector.test.id
+
+  feature {
+    name   = "EKS_RUNTIME_MONITORING"
+    status = %[1]q
+
+    additional_configuration {
+      name   = "EKS_ADDON_MANAGEMENT"
+      status = %[2]q
+    }
+  }
+}
+`, featureStatus, additionalStatus)
+}
@@ -73,8 +73,9 @@ func ResourceFilter() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"field": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validFindingCriterionField,
 									},
 									"equals": {
 										Type:     schema.TypeList,
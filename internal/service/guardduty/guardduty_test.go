@@ -27,6 +27,10 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"datasource_basic":                  testAccDetectorDataSource_basic,
 			"datasource_id":                     testAccDetectorDataSource_ID,
 		},
+		"DetectorFeatures": {
+			"basic":                   testAccDetectorFeatures_basic,
+			"additionalConfiguration": testAccDetectorFeatures_additionalConfiguration,
+		},
 		"Filter": {
 			"basic":      testAccFilter_basic,
 			"update":     testAccFilter_update,
@@ -61,8 +65,9 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"invitationMessage":  testAccMember_invitationMessage,
 		},
 		"PublishingDestination": {
-			"basic":      testAccPublishingDestination_basic,
-			"disappears": testAccPublishingDestination_disappears,
+			"basic":                      testAccPublishingDestination_basic,
+			"disappears":                 testAccPublishingDestination_disappears,
+			"deliveryVerificationWindow": testAccPublishingDestination_deliveryVerificationWindow,
 		},
 	}
 
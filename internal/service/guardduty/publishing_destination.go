@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
@@ -44,6 +45,11 @@ func ResourcePublishingDestination() *schema.Resource {
 				Default:      guardduty.DestinationTypeS3,
 				ValidateFunc: validation.StringInSlice(guardduty.DestinationType_Values(), false),
 			},
+			"delivery_verification_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+			},
 			"destination_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -52,7 +58,15 @@ func ResourcePublishingDestination() *schema.Resource {
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validKMSKeyARN,
+			},
+			"publishing_failure_start_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -86,6 +100,14 @@ func resourcePublishingDestinationCreate(ctx context.Context, d *schema.Resource
 			guardduty.PublishingStatusPublishing, err)
 	}
 
+	if v, ok := d.GetOk("delivery_verification_window"); ok {
+		timeout, _ := time.ParseDuration(v.(string))
+
+		if err := waitPublishingDestinationDeliveryVerified(ctx, conn, aws.StringValue(output.DestinationId), detectorID, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying GuardDuty Publishing Destination (%s) delivery: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourcePublishingDestinationRead(ctx, d, meta)...)
 }
 
@@ -118,6 +140,12 @@ func resourcePublishingDestinationRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("destination_type", gdo.DestinationType)
 	d.Set("kms_key_arn", gdo.DestinationProperties.KmsKeyArn)
 	d.Set("destination_arn", gdo.DestinationProperties.DestinationArn)
+	if v := aws.Int64Value(gdo.PublishingFailureStartTimestamp); v > 0 {
+		d.Set("publishing_failure_start_timestamp", time.UnixMilli(v).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("publishing_failure_start_timestamp", nil)
+	}
+	d.Set("status", gdo.Status)
 	return diags
 }
 
@@ -131,17 +159,27 @@ func resourcePublishingDestinationUpdate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
 	}
 
-	input := guardduty.UpdatePublishingDestinationInput{
-		DestinationId: aws.String(destinationId),
-		DetectorId:    aws.String(detectorId),
-		DestinationProperties: &guardduty.DestinationProperties{
-			DestinationArn: aws.String(d.Get("destination_arn").(string)),
-			KmsKeyArn:      aws.String(d.Get("kms_key_arn").(string)),
-		},
-	}
+	if d.HasChanges("destination_arn", "kms_key_arn") {
+		input := guardduty.UpdatePublishingDestinationInput{
+			DestinationId: aws.String(destinationId),
+			DetectorId:    aws.String(detectorId),
+			DestinationProperties: &guardduty.DestinationProperties{
+				DestinationArn: aws.String(d.Get("destination_arn").(string)),
+				KmsKeyArn:      aws.String(d.Get("kms_key_arn").(string)),
+			},
+		}
 
-	if _, err = conn.UpdatePublishingDestinationWithContext(ctx, &input); err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+		if _, err = conn.UpdatePublishingDestinationWithContext(ctx, &input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+		}
+
+		if v, ok := d.GetOk("delivery_verification_window"); ok {
+			timeout, _ := time.ParseDuration(v.(string))
+
+			if err := waitPublishingDestinationDeliveryVerified(ctx, conn, destinationId, detectorId, timeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "verifying GuardDuty Publishing Destination (%s) delivery: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourcePublishingDestinationRead(ctx, d, meta)...)
@@ -39,7 +39,9 @@ func testAccPublishingDestination_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "detector_id", detectorResourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", bucketResourceName, "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", kmsKeyResourceName, "arn"),
-					resource.TestCheckResourceAttr(resourceName, "destination_type", "S3")),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "S3"),
+					resource.TestCheckResourceAttr(resourceName, "publishing_failure_start_timestamp", ""),
+					resource.TestCheckResourceAttr(resourceName, "status", guardduty.PublishingStatusPublishing)),
 			},
 			{
 				ResourceName:      resourceName,
@@ -50,6 +52,35 @@ func testAccPublishingDestination_basic(t *testing.T) {
 	})
 }
 
+func testAccPublishingDestination_deliveryVerificationWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_publishing_destination.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPublishingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublishingDestinationConfig_deliveryVerificationWindow(bucketName, "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublishingDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_verification_window", "30s"),
+					resource.TestCheckResourceAttr(resourceName, "status", guardduty.PublishingStatusPublishing),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delivery_verification_window"},
+			},
+		},
+	})
+}
+
 func testAccPublishingDestination_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_guardduty_publishing_destination.test"
@@ -73,7 +104,7 @@ func testAccPublishingDestination_disappears(t *testing.T) {
 	})
 }
 
-func testAccPublishingDestinationConfig_basic(bucketName string) string {
+func testAccPublishingDestinationConfig_base(bucketName string) string {
 	return fmt.Sprintf(`
 
 data "aws_caller_identity" "current" {}
@@ -176,7 +207,11 @@ resource "aws_kms_key" "gd_key" {
   deletion_window_in_days = 7
   policy                  = data.aws_iam_policy_document.kms_pol.json
 }
+`, bucketName)
+}
 
+func testAccPublishingDestinationConfig_basic(bucketName string) string {
+	return acctest.ConfigCompose(testAccPublishingDestinationConfig_base(bucketName), `
 resource "aws_guardduty_publishing_destination" "test" {
   detector_id     = aws_guardduty_detector.test_gd.id
   destination_arn = aws_s3_bucket.gd_bucket.arn
@@ -185,7 +220,23 @@ resource "aws_guardduty_publishing_destination" "test" {
   depends_on = [
     aws_s3_bucket_policy.gd_bucket_policy,
   ]
-}`, bucketName)
+}
+`)
+}
+
+func testAccPublishingDestinationConfig_deliveryVerificationWindow(bucketName, window string) string {
+	return acctest.ConfigCompose(testAccPublishingDestinationConfig_base(bucketName), fmt.Sprintf(`
+resource "aws_guardduty_publishing_destination" "test" {
+  detector_id                  = aws_guardduty_detector.test_gd.id
+  destination_arn              = aws_s3_bucket.gd_bucket.arn
+  kms_key_arn                  = aws_kms_key.gd_key.arn
+  delivery_verification_window = %[1]q
+
+  depends_on = [
+    aws_s3_bucket_policy.gd_bucket_policy,
+  ]
+}
+`, window))
 }
 
 func testAccCheckPublishingDestinationExists(ctx context.Context, name string) resource.TestCheckFunc {
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+)
+
+// findingCriterionFreeFormFields are finding fields whose nested attributes are free-form JSON.
+var findingCriterionFreeFormFields = []string{
+	"service.additionalInfo",
+}
+
+var (
+	findingCriterionFieldsOnce sync.Once
+	findingCriterionFields     map[string]struct{}
+)
+
+// validFindingCriterionField validates that a filter criterion field is the dot-separated path
+// of a GuardDuty finding attribute, e.g. "resource.instanceDetails.instanceId".
+func validFindingCriterionField(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, prefix := range findingCriterionFreeFormFields {
+		if strings.HasPrefix(value, prefix+".") {
+			return
+		}
+	}
+
+	findingCriterionFieldsOnce.Do(func() {
+		findingCriterionFields = make(map[string]struct{})
+		addFindingCriterionFields(findingCriterionFields, "", reflect.TypeOf(guardduty.Finding{}), nil)
+	})
+
+	if _, ok := findingCriterionFields[value]; !ok {
+		errors = append(errors, fmt.Errorf("%q (%q) is not a valid GuardDuty finding attribute", k, value))
+	}
+
+	return
+}
+
+// addFindingCriterionFields adds the paths of all scalar attributes of the specified AWS SDK for Go v1 structure type.
+func addFindingCriterionFields(fields map[string]struct{}, prefix string, t reflect.Type, seen []reflect.Type) {
+	for _, v := range seen {
+		if v == t {
+			return
+		}
+	}
+	seen = append(seen, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("locationName")
+
+		if !field.IsExported() || name == "" {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		ft := field.Type
+		for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+		}
+
+		if ft.Kind() == reflect.Struct && ft.PkgPath() == t.PkgPath() {
+			addFindingCriterionFields(fields, path, ft, seen)
+		} else {
+			fields[path] = struct{}{}
+		}
+	}
+}
+
+// validKMSKeyARN validates that a value is the ARN of a KMS key.
+// GuardDuty rejects key IDs, aliases and alias ARNs when exporting findings.
+func validKMSKeyARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != "kms" || !strings.HasPrefix(parsedARN.Resource, "key/") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of a KMS key", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"testing"
+)
+
+func TestValidFindingCriterionField(t *testing.T) {
+	t.Parallel()
+
+	validFields := []string{
+		"accountId",
+		"region",
+		"severity",
+		"type",
+		"updatedAt",
+		"resource.instanceDetails.instanceId",
+		"resource.instanceDetails.networkInterfaces.securityGroups.groupId",
+		"resource.instanceDetails.tags.key",
+		"resource.s3BucketDetails.publicAccess.effectivePermission",
+		"service.action.awsApiCallAction.remoteIpDetails.ipAddressV4",
+		"service.additionalInfo.threatListName",
+		"service.archived",
+	}
+	for _, v := range validFields {
+		_, errors := validFindingCriterionField(v, "field")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid finding criterion field: %q", v, errors)
+		}
+	}
+
+	invalidFields := []string{
+		"",
+		"Region",
+		"resource",
+		"resource.instanceDetails",
+		"resource.instanceDetails.instanceID",
+		"servcie.archived",
+		"service.additionalInfo",
+	}
+	for _, v := range invalidFields {
+		_, errors := validFindingCriterionField(v, "field")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid finding criterion field", v)
+		}
+	}
+}
+
+func TestValidKMSKeyARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validKMSKeyARN(v, "kms_key_arn")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid KMS key ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"alias/example",
+		"arn:aws:kms:us-west-2:123456789012:alias/example",                        //lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:::example-bucket",                                             //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validKMSKeyARN(v, "kms_key_arn")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid KMS key ARN", v)
+		}
+	}
+}
@@ -5,10 +5,13 @@ package guardduty
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -82,3 +85,35 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Guard
 
 	return nil, err
 }
+
+// waitPublishingDestinationDeliveryVerified watches a PublishingDestination for the specified window
+// and returns an error if GuardDuty reports that it is unable to publish findings to it
+func waitPublishingDestinationDeliveryVerified(ctx context.Context, conn *guardduty.GuardDuty, destinationID, detectorID string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{guardduty.PublishingStatusPendingVerification, guardduty.PublishingStatusPublishing},
+		Target:  []string{guardduty.PublishingStatusUnableToPublishFixDestinationProperty},
+		Refresh: statusPublishingDestination(ctx, conn, destinationID, detectorID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	// No publishing failure was reported within the verification window.
+	if tfresource.TimedOut(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output, ok := outputRaw.(*guardduty.DescribePublishingDestinationOutput); ok {
+		if v := aws.Int64Value(output.PublishingFailureStartTimestamp); v > 0 {
+			return fmt.Errorf("status is %q since %s; check the S3 bucket policy and KMS key policy", aws.StringValue(output.Status), time.UnixMilli(v).UTC().Format(time.RFC3339))
+		}
+
+		return fmt.Errorf("status is %q; check the S3 bucket policy and KMS key policy", aws.StringValue(output.Status))
+	}
+
+	return fmt.Errorf("status is %q", guardduty.PublishingStatusUnableToPublishFixDestinationProperty)
+}
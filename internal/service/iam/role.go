@@ -177,6 +177,12 @@ func ResourceRole() *schema.Resource {
 
 func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("force_detach_policies", false)
+
+	// The read that follows import may use minimal detail, so read the role's policies here.
+	if diags := readRole(ctx, d, meta, true); diags.HasError() {
+		return nil, sdkdiag.DiagnosticsError(diags)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -246,7 +252,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		// If default tags only, continue. Otherwise, error.
 		if v, ok := d.GetOk(names.AttrTags); (!ok || len(v.(map[string]interface{})) == 0) && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
-			return append(diags, resourceRoleRead(ctx, d, meta)...)
+			return append(diags, readRole(ctx, d, meta, true)...)
 		}
 
 		if err != nil {
@@ -254,10 +260,16 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	return append(diags, resourceRoleRead(ctx, d, meta)...)
+	return append(diags, readRole(ctx, d, meta, true)...)
 }
 
 func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readRole(ctx, d, meta, d.IsNewResource() || !meta.(*conns.AWSClient).MinimalRefresh())
+}
+
+// readRole reads the role into state. Inline and managed policies are only read when full is set;
+// create, update and import always read them.
+func readRole(ctx context.Context, d *schema.ResourceData, meta interface{}, full bool) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMConn(ctx)
 
@@ -306,27 +318,31 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	d.Set("assume_role_policy", policyToSet)
 
-	inlinePolicies, err := readRoleInlinePolicies(ctx, aws.StringValue(role.RoleName), meta)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading inline policies for IAM role %s, error: %s", d.Id(), err)
-	}
+	// Reading inline and managed policies requires a call per policy.
+	// Skip them when refreshing with minimal detail.
+	if full {
+		inlinePolicies, err := readRoleInlinePolicies(ctx, aws.StringValue(role.RoleName), meta)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading inline policies for IAM role %s, error: %s", d.Id(), err)
+		}
 
-	var configPoliciesList []*iam.PutRolePolicyInput
-	if v := d.Get("inline_policy").(*schema.Set); v.Len() > 0 {
-		configPoliciesList = expandRoleInlinePolicies(aws.StringValue(role.RoleName), v.List())
-	}
+		var configPoliciesList []*iam.PutRolePolicyInput
+		if v := d.Get("inline_policy").(*schema.Set); v.Len() > 0 {
+			configPoliciesList = expandRoleInlinePolicies(aws.StringValue(role.RoleName), v.List())
+		}
 
-	if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
-		if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
+		if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
+			if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
+			}
 		}
-	}
 
-	managedPolicies, err := readRolePolicyAttachments(ctx, conn, aws.StringValue(role.RoleName))
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading managed policies for IAM role %s, error: %s", d.Id(), err)
+		managedPolicies, err := readRolePolicyAttachments(ctx, conn, aws.StringValue(role.RoleName))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading managed policies for IAM role %s, error: %s", d.Id(), err)
+		}
+		d.Set("managed_policy_arns", managedPolicies)
 	}
-	d.Set("managed_policy_arns", managedPolicies)
 
 	setTagsOut(ctx, role.Tags)
 
@@ -491,7 +507,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		// Some partitions (e.g. ISO) may not support tagging.
 		if errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
-			return append(diags, resourceRoleRead(ctx, d, meta)...)
+			return append(diags, readRole(ctx, d, meta, true)...)
 		}
 
 		if err != nil {
@@ -499,7 +515,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	return append(diags, resourceRoleRead(ctx, d, meta)...)
+	return append(diags, readRole(ctx, d, meta, true)...)
 }
 
 func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
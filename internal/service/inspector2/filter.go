@@ -0,0 +1,1051 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_filter", name="Filter")
+// @Tags(identifierAttribute="arn")
+func ResourceFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFilterCreate,
+		ReadWithoutTimeout:   resourceFilterRead,
+		UpdateWithoutTimeout: resourceFilterUpdate,
+		DeleteWithoutTimeout: resourceFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.FilterAction](),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"code_vulnerability_detector_name":   stringFilterSchema(),
+						"code_vulnerability_detector_tags":   stringFilterSchema(),
+						"code_vulnerability_file_path":       stringFilterSchema(),
+						"component_id":                       stringFilterSchema(),
+						"component_type":                     stringFilterSchema(),
+						"ec2_instance_image_id":              stringFilterSchema(),
+						"ec2_instance_subnet_id":             stringFilterSchema(),
+						"ec2_instance_vpc_id":                stringFilterSchema(),
+						"ecr_image_architecture":             stringFilterSchema(),
+						"ecr_image_hash":                     stringFilterSchema(),
+						"ecr_image_pushed_at":                dateFilterSchema(),
+						"ecr_image_registry":                 stringFilterSchema(),
+						"ecr_image_repository_name":          stringFilterSchema(),
+						"ecr_image_tags":                     stringFilterSchema(),
+						"epss_score":                         numberFilterSchema(),
+						"exploit_available":                  stringFilterSchema(),
+						"finding_arn":                        stringFilterSchema(),
+						"finding_status":                     stringFilterSchema(),
+						"finding_type":                       stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"fix_available":                      stringFilterSchema(),
+						"inspector_score":                    numberFilterSchema(),
+						"lambda_function_execution_role_arn": stringFilterSchema(),
+						"lambda_function_last_modified_at":   dateFilterSchema(),
+						"lambda_function_layers":             stringFilterSchema(),
+						"lambda_function_name":               stringFilterSchema(),
+						"lambda_function_runtime":            stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"network_protocol":                   stringFilterSchema(),
+						"port_range":                         portRangeFilterSchema(),
+						"related_vulnerabilities":            stringFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity":                           stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"vendor_severity":                    stringFilterSchema(),
+						"vulnerability_id":                   stringFilterSchema(),
+						"vulnerability_source":               stringFilterSchema(),
+						"vulnerable_packages":                packageFilterSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ForceNewIfChange("description", func(_ context.Context, old, new, meta interface{}) bool {
+				// Any existing value cannot be cleared.
+				return new.(string) == ""
+			}),
+			customdiff.ForceNewIfChange("filter_criteria", func(_ context.Context, old, new, meta interface{}) bool {
+				// All criteria cannot be cleared.
+				return !hasFilterCriteria(new.([]interface{}))
+			}),
+			customdiff.ForceNewIfChange("reason", func(_ context.Context, old, new, meta interface{}) bool {
+				// Any existing value cannot be cleared.
+				return new.(string) == ""
+			}),
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func stringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: stringFilterElemSchema(),
+		},
+	}
+}
+
+func stringFilterElemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"comparison": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: enum.Validate[types.StringComparison](),
+		},
+		"value": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1024),
+		},
+	}
+}
+
+func dateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+				"start_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: numberFilterElemSchema(),
+		},
+	}
+}
+
+func numberFilterElemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"lower_inclusive": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		"upper_inclusive": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+	}
+}
+
+func portRangeFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"begin_inclusive": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"end_inclusive": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func mapFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.MapComparison](),
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+				},
+			},
+		},
+	}
+}
+
+func packageFilterSchema() *schema.Schema {
+	packageStringFilterSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: stringFilterElemSchema(),
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"architecture": packageStringFilterSchema(),
+				"epoch": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: numberFilterElemSchema(),
+					},
+				},
+				"name":                    packageStringFilterSchema(),
+				"release":                 packageStringFilterSchema(),
+				"source_lambda_layer_arn": packageStringFilterSchema(),
+				"source_layer_hash":       packageStringFilterSchema(),
+				"version":                 packageStringFilterSchema(),
+			},
+		},
+	}
+}
+
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	name := d.Get("name").(string)
+	input := &inspector2.CreateFilterInput{
+		Action: types.FilterAction(d.Get("action").(string)),
+		Name:   aws.String(name),
+		Tags:   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		input.Reason = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFilter(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Inspector Filter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	filter, err := FindFilterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Amazon Inspector Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", filter.Action)
+	d.Set("arn", filter.Arn)
+	d.Set("description", filter.Description)
+	if err := d.Set("filter_criteria", flattenFilterCriteria(filter.Criteria)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting filter_criteria: %s", err)
+	}
+	d.Set("name", filter.Name)
+	d.Set("owner_id", filter.OwnerId)
+	d.Set("reason", filter.Reason)
+
+	setTagsOut(ctx, filter.Tags)
+
+	return diags
+}
+
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &inspector2.UpdateFilterInput{
+			Action:    types.FilterAction(d.Get("action").(string)),
+			FilterArn: aws.String(d.Id()),
+			Name:      aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange("reason") {
+			input.Reason = aws.String(d.Get("reason").(string))
+		}
+
+		_, err := conn.UpdateFilter(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Amazon Inspector Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Amazon Inspector Filter: %s", d.Id())
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*types.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	output, err := conn.ListFilters(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Filters {
+		if aws.ToString(v.Arn) == arn {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func hasFilterCriteria(tfList []interface{}) bool {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return false
+	}
+
+	for _, v := range tfList[0].(map[string]interface{}) {
+		if v, ok := v.(*schema.Set); ok && v.Len() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *types.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FilterCriteria{}
+
+	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AwsAccountId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["code_vulnerability_detector_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CodeVulnerabilityDetectorName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["code_vulnerability_detector_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CodeVulnerabilityDetectorTags = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["code_vulnerability_file_path"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CodeVulnerabilityFilePath = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_image_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceImageId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_subnet_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceSubnetId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_vpc_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceVpcId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_architecture"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageArchitecture = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_hash"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageHash = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_pushed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImagePushedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_registry"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRegistry = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_repository_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRepositoryName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageTags = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["epss_score"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EpssScore = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["exploit_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExploitAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingStatus = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FirstObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["fix_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FixAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["inspector_score"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InspectorScore = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_execution_role_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionExecutionRoleArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_last_modified_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLastModifiedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_layers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLayers = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_runtime"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionRuntime = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LastObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_protocol"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkProtocol = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRange = expandPortRangeFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_vulnerabilities"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedVulnerabilities = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTags = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Severity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Title = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["vendor_severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VendorSeverity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilityId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_source"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilitySource = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerable_packages"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerablePackages = expandPackageFilters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandStringFilter(tfMap map[string]interface{}) *types.StringFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StringFilter{}
+
+	if v, ok := tfMap["comparison"].(string); ok && v != "" {
+		apiObject.Comparison = types.StringComparison(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStringFilters(tfList []interface{}) []types.StringFilter {
+	var apiObjects []types.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandStringFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandDateFilters(tfList []interface{}) []types.DateFilter {
+	var apiObjects []types.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DateFilter{}
+
+		if v, ok := tfMap["end_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndInclusive = aws.Time(v)
+		}
+
+		if v, ok := tfMap["start_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartInclusive = aws.Time(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNumberFilter(tfMap map[string]interface{}) *types.NumberFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.NumberFilter{}
+
+	if v, ok := tfMap["lower_inclusive"].(float64); ok {
+		apiObject.LowerInclusive = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["upper_inclusive"].(float64); ok {
+		apiObject.UpperInclusive = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandNumberFilters(tfList []interface{}) []types.NumberFilter {
+	var apiObjects []types.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandNumberFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandPortRangeFilters(tfList []interface{}) []types.PortRangeFilter {
+	var apiObjects []types.PortRangeFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PortRangeFilter{}
+
+		if v, ok := tfMap["begin_inclusive"].(int); ok {
+			apiObject.BeginInclusive = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["end_inclusive"].(int); ok {
+			apiObject.EndInclusive = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMapFilters(tfList []interface{}) []types.MapFilter {
+	var apiObjects []types.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MapFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.MapComparison(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPackageFilters(tfList []interface{}) []types.PackageFilter {
+	var apiObjects []types.PackageFilter
+
+	expandSingle := func(v interface{}) (map[string]interface{}, bool) {
+		tfList, ok := v.([]interface{})
+
+		if !ok || len(tfList) == 0 || tfList[0] == nil {
+			return nil, false
+		}
+
+		return tfList[0].(map[string]interface{}), true
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PackageFilter{}
+
+		if v, ok := expandSingle(tfMap["architecture"]); ok {
+			apiObject.Architecture = expandStringFilter(v)
+		}
+
+		if v, ok := expandSingle(tfMap["epoch"]); ok {
+			apiObject.Epoch = expandNumberFilter(v)
+		}
+
+		if v, ok := expandSingle(tfMap["name"]); ok {
+			apiObject.Name = expandStringFilter(v)
+		}
+
+		if v, ok := expandSingle(tfMap["release"]); ok {
+			apiObject.Release = expandStringFilter(v)
+		}
+
+		if v, ok := expandSingle(tfMap["source_lambda_layer_arn"]); ok {
+			apiObject.SourceLambdaLayerArn = expandStringFilter(v)
+		}
+
+		if v, ok := expandSingle(tfMap["source_layer_hash"]); ok {
+			apiObject.SourceLayerHash = expandStringFilter(v)
+		}
+
+		if v, ok := expandSingle(tfMap["version"]); ok {
+			apiObject.Version = expandStringFilter(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFilterCriteria(apiObject *types.FilterCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_account_id":                     flattenStringFilters(apiObject.AwsAccountId),
+		"code_vulnerability_detector_name":   flattenStringFilters(apiObject.CodeVulnerabilityDetectorName),
+		"code_vulnerability_detector_tags":   flattenStringFilters(apiObject.CodeVulnerabilityDetectorTags),
+		"code_vulnerability_file_path":       flattenStringFilters(apiObject.CodeVulnerabilityFilePath),
+		"component_id":                       flattenStringFilters(apiObject.ComponentId),
+		"component_type":                     flattenStringFilters(apiObject.ComponentType),
+		"ec2_instance_image_id":              flattenStringFilters(apiObject.Ec2InstanceImageId),
+		"ec2_instance_subnet_id":             flattenStringFilters(apiObject.Ec2InstanceSubnetId),
+		"ec2_instance_vpc_id":                flattenStringFilters(apiObject.Ec2InstanceVpcId),
+		"ecr_image_architecture":             flattenStringFilters(apiObject.EcrImageArchitecture),
+		"ecr_image_hash":                     flattenStringFilters(apiObject.EcrImageHash),
+		"ecr_image_pushed_at":                flattenDateFilters(apiObject.EcrImagePushedAt),
+		"ecr_image_registry":                 flattenStringFilters(apiObject.EcrImageRegistry),
+		"ecr_image_repository_name":          flattenStringFilters(apiObject.EcrImageRepositoryName),
+		"ecr_image_tags":                     flattenStringFilters(apiObject.EcrImageTags),
+		"epss_score":                         flattenNumberFilters(apiObject.EpssScore),
+		"exploit_available":                  flattenStringFilters(apiObject.ExploitAvailable),
+		"finding_arn":                        flattenStringFilters(apiObject.FindingArn),
+		"finding_status":                     flattenStringFilters(apiObject.FindingStatus),
+		"finding_type":                       flattenStringFilters(apiObject.FindingType),
+		"first_observed_at":                  flattenDateFilters(apiObject.FirstObservedAt),
+		"fix_available":                      flattenStringFilters(apiObject.FixAvailable),
+		"inspector_score":                    flattenNumberFilters(apiObject.InspectorScore),
+		"lambda_function_execution_role_arn": flattenStringFilters(apiObject.LambdaFunctionExecutionRoleArn),
+		"lambda_function_last_modified_at":   flattenDateFilters(apiObject.LambdaFunctionLastModifiedAt),
+		"lambda_function_layers":             flattenStringFilters(apiObject.LambdaFunctionLayers),
+		"lambda_function_name":               flattenStringFilters(apiObject.LambdaFunctionName),
+		"lambda_function_runtime":            flattenStringFilters(apiObject.LambdaFunctionRuntime),
+		"last_observed_at":                   flattenDateFilters(apiObject.LastObservedAt),
+		"network_protocol":                   flattenStringFilters(apiObject.NetworkProtocol),
+		"port_range":                         flattenPortRangeFilters(apiObject.PortRange),
+		"related_vulnerabilities":            flattenStringFilters(apiObject.RelatedVulnerabilities),
+		"resource_id":                        flattenStringFilters(apiObject.ResourceId),
+		"resource_tags":                      flattenMapFilters(apiObject.ResourceTags),
+		"resource_type":                      flattenStringFilters(apiObject.ResourceType),
+		"severity":                           flattenStringFilters(apiObject.Severity),
+		"title":                              flattenStringFilters(apiObject.Title),
+		"updated_at":                         flattenDateFilters(apiObject.UpdatedAt),
+		"vendor_severity":                    flattenStringFilters(apiObject.VendorSeverity),
+		"vulnerability_id":                   flattenStringFilters(apiObject.VulnerabilityId),
+		"vulnerability_source":               flattenStringFilters(apiObject.VulnerabilitySource),
+		"vulnerable_packages":                flattenPackageFilters(apiObject.VulnerablePackages),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStringFilter(apiObject *types.StringFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison": string(apiObject.Comparison),
+		"value":      aws.ToString(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStringFilters(apiObjects []types.StringFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenStringFilter(&apiObject)...)
+	}
+
+	return tfList
+}
+
+func flattenDateFilters(apiObjects []types.DateFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartInclusive; v != nil {
+			tfMap["start_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenNumberFilter(apiObject *types.NumberFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LowerInclusive; v != nil {
+		tfMap["lower_inclusive"] = aws.ToFloat64(v)
+	}
+
+	if v := apiObject.UpperInclusive; v != nil {
+		tfMap["upper_inclusive"] = aws.ToFloat64(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNumberFilters(apiObjects []types.NumberFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenNumberFilter(&apiObject)...)
+	}
+
+	return tfList
+}
+
+func flattenPortRangeFilters(apiObjects []types.PortRangeFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.BeginInclusive; v != nil {
+			tfMap["begin_inclusive"] = aws.ToInt32(v)
+		}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToInt32(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMapFilters(apiObjects []types.MapFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"comparison": string(apiObject.Comparison),
+			"key":        aws.ToString(apiObject.Key),
+			"value":      aws.ToString(apiObject.Value),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPackageFilters(apiObjects []types.PackageFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"architecture":            flattenStringFilter(apiObject.Architecture),
+			"epoch":                   flattenNumberFilter(apiObject.Epoch),
+			"name":                    flattenStringFilter(apiObject.Name),
+			"release":                 flattenStringFilter(apiObject.Release),
+			"source_lambda_layer_arn": flattenStringFilter(apiObject.SourceLambdaLayerArn),
+			"source_layer_hash":       flattenStringFilter(apiObject.SourceLayerHash),
+			"version":                 flattenStringFilter(apiObject.Version),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -36,6 +36,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEnabler,
 			TypeName: "aws_inspector2_enabler",
 		},
+		{
+			Factory:  ResourceFilter,
+			TypeName: "aws_inspector2_filter",
+			Name:     "Filter",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceMemberAssociation,
 			TypeName: "aws_inspector2_member_association",
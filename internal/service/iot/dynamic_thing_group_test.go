@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDynamicThingGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_dynamic_thing_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDynamicThingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamicThingGroupConfig_basic(rName, "attributes.env: test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupExists(ctx, resourceName, &thingGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(fmt.Sprintf("thinggroup/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "index_name", "AWS_Things"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.env: test"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDynamicThingGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_dynamic_thing_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDynamicThingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamicThingGroupConfig_basic(rName, "attributes.env: test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupExists(ctx, resourceName, &thingGroup),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceDynamicThingGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDynamicThingGroup_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_dynamic_thing_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDynamicThingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamicThingGroupConfig_basic(rName, "attributes.env: test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupExists(ctx, resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.env: test"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccDynamicThingGroupConfig_properties(rName, "attributes.env: prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupExists(ctx, resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.env: prod"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDynamicThingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_dynamic_thing_group" {
+				continue
+			}
+
+			_, err := tfiot.FindThingGroupByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Dynamic Thing Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccDynamicThingGroupConfig_base = `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+`
+
+func testAccDynamicThingGroupConfig_basic(rName, queryString string) string {
+	return acctest.ConfigCompose(testAccDynamicThingGroupConfig_base, fmt.Sprintf(`
+resource "aws_iot_dynamic_thing_group" "test" {
+  name         = %[1]q
+  query_string = %[2]q
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, queryString))
+}
+
+func testAccDynamicThingGroupConfig_properties(rName, queryString string) string {
+	return acctest.ConfigCompose(testAccDynamicThingGroupConfig_base, fmt.Sprintf(`
+resource "aws_iot_dynamic_thing_group" "test" {
+  name         = %[1]q
+  query_string = %[2]q
+
+  properties {
+    attribute_payload {
+      attributes = {
+        Key1 = "Value1"
+      }
+    }
+
+    description = "test description"
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, queryString))
+}
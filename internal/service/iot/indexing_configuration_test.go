@@ -11,17 +11,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
-func TestAccIoTIndexingConfiguration_serial(t *testing.T) {
-	t.Parallel()
-
-	testCases := map[string]func(t *testing.T){
-		"basic":         testAccIndexingConfiguration_basic,
-		"allAttributes": testAccIndexingConfiguration_allAttributes,
-	}
-
-	acctest.RunSerialTests1Level(t, testCases, 0)
-}
-
 func testAccIndexingConfiguration_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_iot_indexing_configuration.test"
@@ -89,6 +78,9 @@ func testAccIndexingConfiguration_allAttributes(t *testing.T) {
 						"type": "Number",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.device_defender_indexing_mode", "VIOLATIONS"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "thing1shadow"),
 					acctest.CheckResourceAttrGreaterThanValue(resourceName, "thing_group_indexing_configuration.0.managed_field.#", 0),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.named_shadow_indexing_mode", "ON"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_connectivity_indexing_mode", "STATUS"),
@@ -140,6 +132,10 @@ resource "aws_iot_indexing_configuration" "test" {
       name = "deviceDefender.securityProfile1.NUMBER_VALUE_BEHAVIOR.lastViolationValue.number"
       type = "Number"
     }
+
+    filter {
+      named_shadow_names = ["thing1shadow"]
+    }
   }
 }
 `
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIoT_serial(t *testing.T) {
+	t.Parallel()
+
+	// Dynamic thing groups depend on the account-wide fleet indexing configuration.
+	testCases := map[string]map[string]func(t *testing.T){
+		"IndexingConfiguration": {
+			"basic":         testAccIndexingConfiguration_basic,
+			"allAttributes": testAccIndexingConfiguration_allAttributes,
+		},
+		"DynamicThingGroup": {
+			"basic":      testAccDynamicThingGroup_basic,
+			"disappears": testAccDynamicThingGroup_disappears,
+			"update":     testAccDynamicThingGroup_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
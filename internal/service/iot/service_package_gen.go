@@ -42,6 +42,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCertificate,
 			TypeName: "aws_iot_certificate",
 		},
+		{
+			Factory:  ResourceDynamicThingGroup,
+			TypeName: "aws_iot_dynamic_thing_group",
+			Name:     "Dynamic Thing Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceIndexingConfiguration,
 			TypeName: "aws_iot_indexing_configuration",
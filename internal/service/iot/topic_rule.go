@@ -70,6 +70,11 @@ func ResourceTopicRule() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"batch_mode": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"log_group_name": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -274,6 +279,11 @@ func ResourceTopicRule() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"batch_mode": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
 									"log_group_name": {
 										Type:     schema.TypeString,
 										Required: true,
@@ -632,6 +642,51 @@ func ResourceTopicRule() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"headers": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"correlation_data": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"message_expiry": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"payload_format_indicator": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"response_topic": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"user_property": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"key": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"value": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"qos": {
 										Type:         schema.TypeInt,
 										Optional:     true,
@@ -988,6 +1043,51 @@ func ResourceTopicRule() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"correlation_data": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"message_expiry": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"payload_format_indicator": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"response_topic": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"user_property": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"qos": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -1415,6 +1515,10 @@ func expandCloudWatchLogsAction(tfList []interface{}) *iot.CloudwatchLogsAction
 	apiObject := &iot.CloudwatchLogsAction{}
 	tfMap := tfList[0].(map[string]interface{})
 
+	if v, ok := tfMap["batch_mode"].(bool); ok {
+		apiObject.BatchMode = aws.Bool(v)
+	}
+
 	if v, ok := tfMap["log_group_name"].(string); ok && v != "" {
 		apiObject.LogGroupName = aws.String(v)
 	}
@@ -1752,6 +1856,10 @@ func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	apiObject := &iot.RepublishAction{}
 	tfMap := tfList[0].(map[string]interface{})
 
+	if v, ok := tfMap["headers"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Headers = expandMQTTHeaders(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["qos"].(int); ok {
 		apiObject.Qos = aws.Int64(int64(v))
 	}
@@ -1767,6 +1875,59 @@ func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	return apiObject
 }
 
+func expandMQTTHeaders(tfMap map[string]interface{}) *iot.MqttHeaders {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.MqttHeaders{}
+
+	if v, ok := tfMap["content_type"].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["correlation_data"].(string); ok && v != "" {
+		apiObject.CorrelationData = aws.String(v)
+	}
+
+	if v, ok := tfMap["message_expiry"].(string); ok && v != "" {
+		apiObject.MessageExpiry = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload_format_indicator"].(string); ok && v != "" {
+		apiObject.PayloadFormatIndicator = aws.String(v)
+	}
+
+	if v, ok := tfMap["response_topic"].(string); ok && v != "" {
+		apiObject.ResponseTopic = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_property"].([]interface{}); ok && len(v) > 0 {
+		apiObject.UserProperties = expandUserProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandUserProperties(tfList []interface{}) []*iot.UserProperty {
+	var apiObjects []*iot.UserProperty
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iot.UserProperty{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandS3Action(tfList []interface{}) *iot.S3Action {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -2414,6 +2575,10 @@ func flattenCloudWatchLogsAction(apiObject *iot.CloudwatchLogsAction) []interfac
 
 	tfMap := make(map[string]interface{})
 
+	if v := apiObject.BatchMode; v != nil {
+		tfMap["batch_mode"] = aws.BoolValue(v)
+	}
+
 	if v := apiObject.LogGroupName; v != nil {
 		tfMap["log_group_name"] = aws.StringValue(v)
 	}
@@ -2970,6 +3135,10 @@ func flattenRepublishAction(apiObject *iot.RepublishAction) []interface{} {
 
 	tfMap := make(map[string]interface{})
 
+	if v := apiObject.Headers; v != nil {
+		tfMap["headers"] = []interface{}{flattenMQTTHeaders(v)}
+	}
+
 	if v := apiObject.Qos; v != nil {
 		tfMap["qos"] = aws.Int64Value(v)
 	}
@@ -2985,6 +3154,57 @@ func flattenRepublishAction(apiObject *iot.RepublishAction) []interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenMQTTHeaders(apiObject *iot.MqttHeaders) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.ContentType; v != nil {
+		tfMap["content_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CorrelationData; v != nil {
+		tfMap["correlation_data"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MessageExpiry; v != nil {
+		tfMap["message_expiry"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PayloadFormatIndicator; v != nil {
+		tfMap["payload_format_indicator"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResponseTopic; v != nil {
+		tfMap["response_topic"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserProperties; v != nil {
+		tfMap["user_property"] = flattenUserProperties(v)
+	}
+
+	return tfMap
+}
+
+func flattenUserProperties(apiObjects []*iot.UserProperty) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   aws.StringValue(apiObject.Key),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
 // Legacy root attribute handling
 func flattenS3Actions(actions []*iot.Action) []interface{} {
 	results := make([]interface{}, 0)
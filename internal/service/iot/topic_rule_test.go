@@ -219,9 +219,11 @@ func TestAccIoTTopicRule_cloudWatchLogs(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_logs.*", map[string]string{
+						"batch_mode":     "false",
 						"log_group_name": "mylogs1",
 					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_logs.*", map[string]string{
+						"batch_mode":     "true",
 						"log_group_name": "mylogs2",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_metric.#", "0"),
@@ -1376,6 +1378,48 @@ func TestAccIoTTopicRule_republishWithQos(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_republishWithHeaders(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_republishHeaders(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "republish.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "republish.*", map[string]string{
+						"headers.#":                          "1",
+						"headers.0.content_type":             "application/json",
+						"headers.0.correlation_data":         "c29tZSBjb3JyZWxhdGlvbiBkYXRh",
+						"headers.0.message_expiry":           "100",
+						"headers.0.payload_format_indicator": "UTF8_DATA",
+						"headers.0.response_topic":           "response/topic",
+						"headers.0.user_property.#":          "2",
+						"headers.0.user_property.0.key":      "key1",
+						"headers.0.user_property.0.value":    "value1",
+						"headers.0.user_property.1.key":      "key2",
+						"headers.0.user_property.1.value":    "value2",
+						"qos":                                "1",
+						"topic":                              "mytopic",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -1973,6 +2017,7 @@ resource "aws_iot_topic_rule" "test" {
   }
 
   cloudwatch_logs {
+    batch_mode     = true
     log_group_name = "mylogs2"
     role_arn       = aws_iam_role.test.arn
   }
@@ -2419,6 +2464,43 @@ resource "aws_iot_topic_rule" "test" {
 `, rName))
 }
 
+func testAccTopicRuleConfig_republishHeaders(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2016-03-23"
+
+  republish {
+    role_arn = aws_iam_role.test.arn
+    topic    = "mytopic"
+    qos      = 1
+
+    headers {
+      content_type             = "application/json"
+      correlation_data         = "c29tZSBjb3JyZWxhdGlvbiBkYXRh"
+      message_expiry           = "100"
+      payload_format_indicator = "UTF8_DATA"
+      response_topic           = "response/topic"
+
+      user_property {
+        key   = "key1"
+        value = "value1"
+      }
+
+      user_property {
+        key   = "key2"
+        value = "value2"
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTopicRuleConfig_s3(rName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
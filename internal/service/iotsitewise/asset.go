@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_asset", name="Asset")
+// @Tags(identifierAttribute="arn")
+func ResourceAsset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetCreate,
+		ReadWithoutTimeout:   resourceAssetRead,
+		UpdateWithoutTimeout: resourceAssetUpdate,
+		DeleteWithoutTimeout: resourceAssetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"asset_hierarchy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"asset_model_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"asset_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"asset_property": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get("asset_name").(string)
+	input := &iotsitewise.CreateAssetInput{
+		AssetModelId: aws.String(d.Get("asset_model_id").(string)),
+		AssetName:    aws.String(name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("asset_description"); ok {
+		input.AssetDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAssetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetId))
+
+	if _, err := waitAssetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAssetRead(ctx, d, meta)...)
+}
+
+func resourceAssetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := FindAssetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.AssetArn)
+	d.Set("asset_description", output.AssetDescription)
+	if err := d.Set("asset_hierarchy", flattenAssetHierarchies(output.AssetHierarchies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset_hierarchy: %s", err)
+	}
+	d.Set("asset_model_id", output.AssetModelId)
+	d.Set("asset_name", output.AssetName)
+	if err := d.Set("asset_property", flattenAssetProperties(output.AssetProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset_property: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAssetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChanges("asset_description", "asset_name") {
+		input := &iotsitewise.UpdateAssetInput{
+			AssetId:   aws.String(d.Id()),
+			AssetName: aws.String(d.Get("asset_name").(string)),
+		}
+
+		if v, ok := d.GetOk("asset_description"); ok {
+			input.AssetDescription = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAssetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetRead(ctx, d, meta)...)
+}
+
+func resourceAssetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset: %s", d.Id())
+	_, err := conn.DeleteAssetWithContext(ctx, &iotsitewise.DeleteAssetInput{
+		AssetId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func flattenAssetHierarchies(apiObjects []*iotsitewise.AssetHierarchy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":   aws.StringValue(apiObject.Id),
+			"name": aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAssetProperties(apiObjects []*iotsitewise.AssetProperty) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"alias":     aws.StringValue(apiObject.Alias),
+			"data_type": aws.StringValue(apiObject.DataType),
+			"id":        aws.StringValue(apiObject.Id),
+			"name":      aws.StringValue(apiObject.Name),
+			"unit":      aws.StringValue(apiObject.Unit),
+		})
+	}
+
+	return tfList
+}
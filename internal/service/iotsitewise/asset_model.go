@@ -0,0 +1,865 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_asset_model", name="Asset Model")
+// @Tags(identifierAttribute="arn")
+func ResourceAssetModel() *schema.Resource {
+	forwardingConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"state": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(iotsitewise.ForwardingConfigState_Values(), false),
+					},
+				},
+			},
+		}
+	}
+	variableSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"value": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"hierarchy_id": {
+									Type:             schema.TypeString,
+									Optional:         true,
+									DiffSuppressFunc: suppressAssetModelHierarchyIDDiff,
+								},
+								"property_id": {
+									Type:             schema.TypeString,
+									Required:         true,
+									DiffSuppressFunc: suppressAssetModelPropertyIDDiff,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetModelCreate,
+		ReadWithoutTimeout:   resourceAssetModelRead,
+		UpdateWithoutTimeout: resourceAssetModelUpdate,
+		DeleteWithoutTimeout: resourceAssetModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_model_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"asset_model_hierarchy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_asset_model_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"asset_model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"asset_model_property": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iotsitewise.PropertyDataType_Values(), false),
+						},
+						"data_type_spec": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"type": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"measurement": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"processing_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"forwarding_config": forwardingConfigSchema(),
+														},
+													},
+												},
+											},
+										},
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"processing_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"compute_location": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+															},
+														},
+													},
+												},
+												"variable": variableSchema(),
+												"window": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"tumbling": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"interval": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringLenBetween(2, 23),
+																		},
+																		"offset": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringLenBetween(2, 25),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"transform": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"processing_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"compute_location": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+															},
+															"forwarding_config": forwardingConfigSchema(),
+														},
+													},
+												},
+												"variable": variableSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssetModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get("asset_model_name").(string)
+	input := &iotsitewise.CreateAssetModelInput{
+		AssetModelName: aws.String(name),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("asset_model_description"); ok {
+		input.AssetModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("asset_model_hierarchy"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelHierarchies = expandAssetModelHierarchyDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("asset_model_property"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelProperties = expandAssetModelPropertyDefinitions(v.([]interface{}))
+	}
+
+	output, err := conn.CreateAssetModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset Model (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetModelId))
+
+	if _, err := waitAssetModelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := FindAssetModelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.AssetModelArn)
+	d.Set("asset_model_description", output.AssetModelDescription)
+	if err := d.Set("asset_model_hierarchy", flattenAssetModelHierarchies(output.AssetModelHierarchies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset_model_hierarchy: %s", err)
+	}
+	d.Set("asset_model_name", output.AssetModelName)
+	if err := d.Set("asset_model_property", flattenAssetModelProperties(output.AssetModelProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset_model_property: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAssetModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotsitewise.UpdateAssetModelInput{
+			AssetModelId:   aws.String(d.Id()),
+			AssetModelName: aws.String(d.Get("asset_model_name").(string)),
+		}
+
+		if v, ok := d.GetOk("asset_model_description"); ok {
+			input.AssetModelDescription = aws.String(v.(string))
+		}
+
+		// Existing hierarchies and properties are identified by ID; anything without an ID is created.
+		o, n := d.GetChange("asset_model_hierarchy")
+		input.AssetModelHierarchies = expandAssetModelHierarchies(n.([]interface{}), idsByName(o.([]interface{})))
+
+		o, n = d.GetChange("asset_model_property")
+		input.AssetModelProperties = expandAssetModelProperties(n.([]interface{}), idsByName(o.([]interface{})))
+
+		_, err := conn.UpdateAssetModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetModelUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset Model: %s", d.Id())
+	_, err := conn.DeleteAssetModelWithContext(ctx, &iotsitewise.DeleteAssetModelInput{
+		AssetModelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// Expression variables may reference properties and hierarchies of the same asset model by name,
+// but the API always returns their IDs.
+func suppressAssetModelPropertyIDDiff(k, old, new string, d *schema.ResourceData) bool {
+	return idsByName(d.Get("asset_model_property").([]interface{}))[new] == old && old != ""
+}
+
+func suppressAssetModelHierarchyIDDiff(k, old, new string, d *schema.ResourceData) bool {
+	return idsByName(d.Get("asset_model_hierarchy").([]interface{}))[new] == old && old != ""
+}
+
+func idsByName(tfList []interface{}) map[string]string {
+	ids := make(map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if name, id := tfMap["name"].(string), tfMap["id"].(string); name != "" && id != "" {
+			ids[name] = id
+		}
+	}
+
+	return ids
+}
+
+func expandAssetModelHierarchyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelHierarchyDefinition {
+	var apiObjects []*iotsitewise.AssetModelHierarchyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iotsitewise.AssetModelHierarchyDefinition{
+			ChildAssetModelId: aws.String(tfMap["child_asset_model_id"].(string)),
+			Name:              aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelHierarchies(tfList []interface{}, ids map[string]string) []*iotsitewise.AssetModelHierarchy {
+	apiObjects := []*iotsitewise.AssetModelHierarchy{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject := &iotsitewise.AssetModelHierarchy{
+			ChildAssetModelId: aws.String(tfMap["child_asset_model_id"].(string)),
+			Name:              aws.String(name),
+		}
+
+		if v, ok := ids[name]; ok {
+			apiObject.Id = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelPropertyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelPropertyDefinition {
+	var apiObjects []*iotsitewise.AssetModelPropertyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.AssetModelPropertyDefinition{
+			DataType: aws.String(tfMap["data_type"].(string)),
+			Name:     aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["data_type_spec"].(string); ok && v != "" {
+			apiObject.DataTypeSpec = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Type = expandPropertyType(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["unit"].(string); ok && v != "" {
+			apiObject.Unit = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelProperties(tfList []interface{}, ids map[string]string) []*iotsitewise.AssetModelProperty {
+	apiObjects := []*iotsitewise.AssetModelProperty{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject := &iotsitewise.AssetModelProperty{
+			DataType: aws.String(tfMap["data_type"].(string)),
+			Name:     aws.String(name),
+		}
+
+		if v, ok := tfMap["data_type_spec"].(string); ok && v != "" {
+			apiObject.DataTypeSpec = aws.String(v)
+		}
+
+		if v, ok := ids[name]; ok {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Type = expandPropertyType(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["unit"].(string); ok && v != "" {
+			apiObject.Unit = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPropertyType(tfMap map[string]interface{}) *iotsitewise.PropertyType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotsitewise.PropertyType{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Attribute = &iotsitewise.Attribute{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["default_value"].(string); ok && v != "" {
+				apiObject.Attribute.DefaultValue = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["measurement"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Measurement = &iotsitewise.Measurement{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["processing_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.Measurement.ProcessingConfig = &iotsitewise.MeasurementProcessingConfig{
+					ForwardingConfig: expandForwardingConfig(v[0].(map[string]interface{})["forwarding_config"].([]interface{})),
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Metric = expandMetric(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["transform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Transform = expandTransform(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandForwardingConfig(tfList []interface{}) *iotsitewise.ForwardingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return &iotsitewise.ForwardingConfig{
+		State: aws.String(tfList[0].(map[string]interface{})["state"].(string)),
+	}
+}
+
+func expandMetric(tfMap map[string]interface{}) *iotsitewise.Metric {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotsitewise.Metric{}
+
+	if v, ok := tfMap["expression"].(string); ok && v != "" {
+		apiObject.Expression = aws.String(v)
+	}
+
+	if v, ok := tfMap["processing_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProcessingConfig = &iotsitewise.MetricProcessingConfig{
+			ComputeLocation: aws.String(v[0].(map[string]interface{})["compute_location"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["variable"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Variables = expandExpressionVariables(v)
+	}
+
+	if v, ok := tfMap["window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Window = &iotsitewise.MetricWindow{}
+
+		if v, ok := v[0].(map[string]interface{})["tumbling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Window.Tumbling = &iotsitewise.TumblingWindow{
+				Interval: aws.String(tfMap["interval"].(string)),
+			}
+
+			if v, ok := tfMap["offset"].(string); ok && v != "" {
+				apiObject.Window.Tumbling.Offset = aws.String(v)
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandTransform(tfMap map[string]interface{}) *iotsitewise.Transform {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotsitewise.Transform{}
+
+	if v, ok := tfMap["expression"].(string); ok && v != "" {
+		apiObject.Expression = aws.String(v)
+	}
+
+	if v, ok := tfMap["processing_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ProcessingConfig = &iotsitewise.TransformProcessingConfig{
+			ComputeLocation:  aws.String(tfMap["compute_location"].(string)),
+			ForwardingConfig: expandForwardingConfig(tfMap["forwarding_config"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["variable"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Variables = expandExpressionVariables(v)
+	}
+
+	return apiObject
+}
+
+func expandExpressionVariables(tfList []interface{}) []*iotsitewise.ExpressionVariable {
+	var apiObjects []*iotsitewise.ExpressionVariable
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.ExpressionVariable{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Value = &iotsitewise.VariableValue{
+				PropertyId: aws.String(tfMap["property_id"].(string)),
+			}
+
+			if v, ok := tfMap["hierarchy_id"].(string); ok && v != "" {
+				apiObject.Value.HierarchyId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAssetModelHierarchies(apiObjects []*iotsitewise.AssetModelHierarchy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"child_asset_model_id": aws.StringValue(apiObject.ChildAssetModelId),
+			"id":                   aws.StringValue(apiObject.Id),
+			"name":                 aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAssetModelProperties(apiObjects []*iotsitewise.AssetModelProperty) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"data_type":      aws.StringValue(apiObject.DataType),
+			"data_type_spec": aws.StringValue(apiObject.DataTypeSpec),
+			"id":             aws.StringValue(apiObject.Id),
+			"name":           aws.StringValue(apiObject.Name),
+			"unit":           aws.StringValue(apiObject.Unit),
+		}
+
+		if v := apiObject.Type; v != nil {
+			tfMap["type"] = []interface{}{flattenPropertyType(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPropertyType(apiObject *iotsitewise.PropertyType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attribute; v != nil {
+		tfMap["attribute"] = []interface{}{map[string]interface{}{
+			"default_value": aws.StringValue(v.DefaultValue),
+		}}
+	}
+
+	if v := apiObject.Measurement; v != nil {
+		m := map[string]interface{}{}
+
+		if v := v.ProcessingConfig; v != nil {
+			m["processing_config"] = []interface{}{map[string]interface{}{
+				"forwarding_config": flattenForwardingConfig(v.ForwardingConfig),
+			}}
+		}
+
+		tfMap["measurement"] = []interface{}{m}
+	}
+
+	if v := apiObject.Metric; v != nil {
+		m := map[string]interface{}{
+			"expression": aws.StringValue(v.Expression),
+			"variable":   flattenExpressionVariables(v.Variables),
+		}
+
+		if v := v.ProcessingConfig; v != nil {
+			m["processing_config"] = []interface{}{map[string]interface{}{
+				"compute_location": aws.StringValue(v.ComputeLocation),
+			}}
+		}
+
+		if v := v.Window; v != nil && v.Tumbling != nil {
+			m["window"] = []interface{}{map[string]interface{}{
+				"tumbling": []interface{}{map[string]interface{}{
+					"interval": aws.StringValue(v.Tumbling.Interval),
+					"offset":   aws.StringValue(v.Tumbling.Offset),
+				}},
+			}}
+		}
+
+		tfMap["metric"] = []interface{}{m}
+	}
+
+	if v := apiObject.Transform; v != nil {
+		m := map[string]interface{}{
+			"expression": aws.StringValue(v.Expression),
+			"variable":   flattenExpressionVariables(v.Variables),
+		}
+
+		if v := v.ProcessingConfig; v != nil {
+			m["processing_config"] = []interface{}{map[string]interface{}{
+				"compute_location":  aws.StringValue(v.ComputeLocation),
+				"forwarding_config": flattenForwardingConfig(v.ForwardingConfig),
+			}}
+		}
+
+		tfMap["transform"] = []interface{}{m}
+	}
+
+	return tfMap
+}
+
+func flattenForwardingConfig(apiObject *iotsitewise.ForwardingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"state": aws.StringValue(apiObject.State),
+	}}
+}
+
+func flattenExpressionVariables(apiObjects []*iotsitewise.ExpressionVariable) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = []interface{}{map[string]interface{}{
+				"hierarchy_id": aws.StringValue(v.HierarchyId),
+				"property_id":  aws.StringValue(v.PropertyId),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
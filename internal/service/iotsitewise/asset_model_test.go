@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSiteWiseAssetModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotsitewise", regexp.MustCompile(`asset-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_description", ""),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_hierarchy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.0.data_type", iotsitewise.PropertyDataTypeString),
+					resource.TestCheckResourceAttrSet(resourceName, "asset_model_property.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.0.name", "Location"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.0.type.0.attribute.0.default_value", "Renton"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.1.data_type", iotsitewise.PropertyDataTypeDouble),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.1.name", "Temperature C"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.1.type.0.measurement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.1.unit", "Celsius"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.2.name", "Temperature F"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.2.type.0.transform.0.expression", "temp_c * 9 / 5 + 32"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.2.type.0.transform.0.variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.2.type.0.transform.0.variable.0.name", "temp_c"),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_property.2.type.0.transform.0.variable.0.value.0.property_id", resourceName, "asset_model_property.1.id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAssetModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetModelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAssetModelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.#", "3"),
+				),
+			},
+			{
+				Config: testAccAssetModelConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_hierarchy.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_hierarchy.0.child_asset_model_id", "aws_iotsitewise_asset_model.child", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "asset_model_hierarchy.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_hierarchy.0.name", "Sensors"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.3.name", "Average Temperature C"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.3.type.0.metric.0.expression", "avg(temp_c)"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_property.3.type.0.metric.0.window.0.tumbling.0.interval", "5m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssetModelExists(ctx context.Context, n string, v *iotsitewise.DescribeAssetModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT SiteWise Asset Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		output, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssetModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset_model" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAssetModelConfig_properties = `
+  asset_model_property {
+    name      = "Location"
+    data_type = "STRING"
+
+    type {
+      attribute {
+        default_value = "Renton"
+      }
+    }
+  }
+
+  asset_model_property {
+    name      = "Temperature C"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      measurement {}
+    }
+  }
+
+  asset_model_property {
+    name      = "Temperature F"
+    data_type = "DOUBLE"
+    unit      = "Fahrenheit"
+
+    type {
+      transform {
+        expression = "temp_c * 9 / 5 + 32"
+
+        variable {
+          name = "temp_c"
+
+          value {
+            property_id = "Temperature C"
+          }
+        }
+      }
+    }
+  }
+`
+
+func testAccAssetModelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  asset_model_name = %[1]q
+%[2]s
+}
+`, rName, testAccAssetModelConfig_properties)
+}
+
+func testAccAssetModelConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "child" {
+  asset_model_name = "%[1]s-child"
+}
+
+resource "aws_iotsitewise_asset_model" "test" {
+  asset_model_description = "updated"
+  asset_model_name        = %[1]q
+
+  asset_model_hierarchy {
+    child_asset_model_id = aws_iotsitewise_asset_model.child.id
+    name                 = "Sensors"
+  }
+%[2]s
+  asset_model_property {
+    name      = "Average Temperature C"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      metric {
+        expression = "avg(temp_c)"
+
+        variable {
+          name = "temp_c"
+
+          value {
+            property_id = "Temperature C"
+          }
+        }
+
+        window {
+          tumbling {
+            interval = "5m"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, testAccAssetModelConfig_properties)
+}
+
+func testAccAssetModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  asset_model_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAssetModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  asset_model_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSiteWiseAsset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotsitewise", regexp.MustCompile(`asset/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "asset_description", ""),
+					resource.TestCheckResourceAttr(resourceName, "asset_hierarchy.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_id", "aws_iotsitewise_asset_model.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "asset_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "asset_property.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_basic(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "asset_name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAsset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssetExists(ctx context.Context, n string, v *iotsitewise.DescribeAssetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT SiteWise Asset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		output, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetConfig_basic(rName, assetName string) string {
+	return acctest.ConfigCompose(testAccAssetModelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+  asset_name     = %[1]q
+}
+`, assetName))
+}
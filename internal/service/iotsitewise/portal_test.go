@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSiteWisePortal_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribePortalOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarms.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotsitewise", regexp.MustCompile(`portal/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "portal_auth_mode", iotsitewise.AuthModeIam),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_client_id"),
+					resource.TestCheckResourceAttr(resourceName, "portal_contact_email", "admin@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "portal_description", ""),
+					resource.TestCheckResourceAttr(resourceName, "portal_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_start_url"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWisePortal_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribePortalOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourcePortal(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWisePortal_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribePortalOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "portal_contact_email", "admin@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "portal_description", ""),
+				),
+			},
+			{
+				Config: testAccPortalConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "portal_contact_email", "operations@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "portal_description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPortalExists(ctx context.Context, n string, v *iotsitewise.DescribePortalOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT SiteWise Portal ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		output, err := tfiotsitewise.FindPortalByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPortalDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_portal" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindPortalByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Portal %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPortalConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "monitor.iotsitewise.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPortalConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPortalConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_portal" "test" {
+  portal_auth_mode     = "IAM"
+  portal_contact_email = "admin@example.com"
+  portal_name          = %[1]q
+  role_arn             = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccPortalConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccPortalConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_portal" "test" {
+  portal_auth_mode     = "IAM"
+  portal_contact_email = "operations@example.com"
+  portal_description   = "updated"
+  portal_name          = %[1]q
+  role_arn             = aws_iam_role.test.arn
+}
+`, rName))
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusAsset(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssetStatus.State), nil
+	}
+}
+
+func statusAssetModel(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssetModelByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssetModelStatus.State), nil
+	}
+}
+
+func statusPortal(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPortalByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PortalStatus.State), nil
+	}
+}
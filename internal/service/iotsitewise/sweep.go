@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package iotsitewise
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotsitewise_asset", &resource.Sweeper{
+		Name: "aws_iotsitewise_asset",
+		F:    sweepAssets,
+	})
+
+	resource.AddTestSweepers("aws_iotsitewise_asset_model", &resource.Sweeper{
+		Name: "aws_iotsitewise_asset_model",
+		F:    sweepAssetModels,
+		Dependencies: []string{
+			"aws_iotsitewise_asset",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotsitewise_gateway", &resource.Sweeper{
+		Name: "aws_iotsitewise_gateway",
+		F:    sweepGateways,
+	})
+
+	resource.AddTestSweepers("aws_iotsitewise_portal", &resource.Sweeper{
+		Name: "aws_iotsitewise_portal",
+		F:    sweepPortals,
+	})
+}
+
+func sweepAssets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTSiteWiseConn(ctx)
+	input := &iotsitewise.ListAssetModelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAssetModelsPagesWithContext(ctx, input, func(page *iotsitewise.ListAssetModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssetModelSummaries {
+			input := &iotsitewise.ListAssetsInput{
+				AssetModelId: v.Id,
+			}
+
+			err := conn.ListAssetsPagesWithContext(ctx, input, func(page *iotsitewise.ListAssetsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.AssetSummaries {
+					r := ResourceAsset()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.Id))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				log.Printf("[WARN] Listing IoT SiteWise Assets for Asset Model (%s): %s", aws.StringValue(v.Id), err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT SiteWise Asset sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT SiteWise Asset Models (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT SiteWise Assets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAssetModels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTSiteWiseConn(ctx)
+	input := &iotsitewise.ListAssetModelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAssetModelsPagesWithContext(ctx, input, func(page *iotsitewise.ListAssetModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssetModelSummaries {
+			r := ResourceAssetModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT SiteWise Asset Model sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT SiteWise Asset Models (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT SiteWise Asset Models (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepGateways(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTSiteWiseConn(ctx)
+	input := &iotsitewise.ListGatewaysInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListGatewaysPagesWithContext(ctx, input, func(page *iotsitewise.ListGatewaysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GatewaySummaries {
+			r := ResourceGateway()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.GatewayId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT SiteWise Gateway sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT SiteWise Gateways (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT SiteWise Gateways (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPortals(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTSiteWiseConn(ctx)
+	input := &iotsitewise.ListPortalsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPortalsPagesWithContext(ctx, input, func(page *iotsitewise.ListPortalsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PortalSummaries {
+			r := ResourcePortal()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT SiteWise Portal sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT SiteWise Portals (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT SiteWise Portals (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitAssetCreated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateCreating},
+		Target:  []string{iotsitewise.AssetStateActive},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		if status := output.AssetStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetUpdated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateUpdating},
+		Target:  []string{iotsitewise.AssetStateActive},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		if status := output.AssetStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateDeleting},
+		Target:  []string{},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		if status := output.AssetStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelCreated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateCreating},
+		Target:  []string{iotsitewise.AssetModelStateActive},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if status := output.AssetModelStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelUpdated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateUpdating, iotsitewise.AssetModelStatePropagating},
+		Target:  []string{iotsitewise.AssetModelStateActive},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if status := output.AssetModelStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateDeleting},
+		Target:  []string{},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if status := output.AssetModelStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPortalCreated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribePortalOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.PortalStateCreating},
+		Target:  []string{iotsitewise.PortalStateActive},
+		Refresh: statusPortal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribePortalOutput); ok {
+		if status := output.PortalStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPortalUpdated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribePortalOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.PortalStateUpdating},
+		Target:  []string{iotsitewise.PortalStateActive},
+		Refresh: statusPortal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribePortalOutput); ok {
+		if status := output.PortalStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPortalDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribePortalOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.PortalStateDeleting},
+		Target:  []string{},
+		Refresh: statusPortal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribePortalOutput); ok {
+		if status := output.PortalStatus; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
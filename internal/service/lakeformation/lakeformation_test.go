@@ -30,6 +30,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"lfTagPolicy":         testAccPermissions_lfTagPolicy,
 			"lfTagPolicyMultiple": testAccPermissions_lfTagPolicyMultiple,
 		},
+		"PermissionsBatch": {
+			"basic":       testAccPermissionsBatch_basic,
+			"updateEntry": testAccPermissionsBatch_updateEntry,
+		},
 		"PermissionsDataSource": {
 			"basic":            testAccPermissionsDataSource_basic,
 			"database":         testAccPermissionsDataSource_database,
@@ -0,0 +1,771 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// BatchGrantPermissions and BatchRevokePermissions accept at most 20 entries per call.
+	permissionsBatchMaxEntries = 20
+)
+
+// @SDKResource("aws_lakeformation_permissions_batch", name="Permissions Batch")
+func ResourcePermissionsBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionsBatchCreate,
+		ReadWithoutTimeout:   resourcePermissionsBatchRead,
+		UpdateWithoutTimeout: resourcePermissionsBatchUpdate,
+		DeleteWithoutTimeout: resourcePermissionsBatchDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"entry": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_resource": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"data_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"database": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"lf_tag": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateLFTagValues(),
+										},
+									},
+								},
+							},
+						},
+						"lf_tag_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"expression": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateLFTagValues(),
+													},
+												},
+											},
+										},
+									},
+									"resource_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(lakeformation.ResourceType_Values(), false),
+									},
+								},
+							},
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPrincipal,
+						},
+						"table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+						"table_with_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"excluded_column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePermissionsBatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	entries, err := expandPermissionsBatchEntries(d.Get("entry").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Permissions Batch: %s", err)
+	}
+
+	if err := batchGrantPermissions(ctx, conn, d.Get("catalog_id").(string), entries, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Permissions Batch: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return append(diags, resourcePermissionsBatchRead(ctx, d, meta)...)
+}
+
+func resourcePermissionsBatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	input := &lakeformation.ListPermissionsInput{}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	// A single paginated listing of the whole catalog replaces one ListPermissions call per entry.
+	granted := make(map[string]struct{})
+
+	err := conn.ListPermissionsPagesWithContext(ctx, input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v == nil || v.Principal == nil || v.Resource == nil {
+				continue
+			}
+
+			key := permissionsBatchKey(aws.StringValue(v.Principal.DataLakePrincipalIdentifier), v.Resource)
+
+			for _, p := range v.Permissions {
+				granted[key+"|"+aws.StringValue(p)] = struct{}{}
+			}
+
+			for _, p := range v.PermissionsWithGrantOption {
+				granted[key+"|grant|"+aws.StringValue(p)] = struct{}{}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+	}
+
+	tfList := make([]interface{}, 0)
+
+	for _, tfMapRaw := range d.Get("entry").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		entry, err := expandPermissionsBatchEntry(tfMap)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+		}
+
+		key := permissionsBatchKey(aws.StringValue(entry.Principal.DataLakePrincipalIdentifier), entry.Resource)
+
+		var permissions, permissionsWithGrantOption []string
+
+		for _, p := range aws.StringValueSlice(entry.Permissions) {
+			if _, ok := granted[key+"|"+p]; ok {
+				permissions = append(permissions, p)
+			}
+		}
+
+		for _, p := range aws.StringValueSlice(entry.PermissionsWithGrantOption) {
+			if _, ok := granted[key+"|grant|"+p]; ok {
+				permissionsWithGrantOption = append(permissionsWithGrantOption, p)
+			}
+		}
+
+		if len(permissions) == 0 {
+			log.Printf("[WARN] Lake Formation Permissions Batch (%s) entry for principal %s not found, removing from state", d.Id(), aws.StringValue(entry.Principal.DataLakePrincipalIdentifier))
+			continue
+		}
+
+		tfMap["permissions"] = flex.FlattenStringValueSet(permissions)
+		tfMap["permissions_with_grant_option"] = flex.FlattenStringValueSet(permissionsWithGrantOption)
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if !d.IsNewResource() && len(tfList) == 0 {
+		log.Printf("[WARN] Lake Formation Permissions Batch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("entry", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePermissionsBatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	if d.HasChange("entry") {
+		o, n := d.GetChange("entry")
+		catalogID := d.Get("catalog_id").(string)
+
+		oldEntries, err := expandPermissionsBatchEntries(o.(*schema.Set).List())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+		}
+
+		newEntries, err := expandPermissionsBatchEntries(n.(*schema.Set).List())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+		}
+
+		// Grant before revoking so that principals keep the permissions common to the old and new entries throughout.
+		add, del := permissionsBatchDiff(oldEntries, newEntries)
+
+		if err := batchGrantPermissions(ctx, conn, catalogID, add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+		}
+
+		if err := batchRevokePermissions(ctx, conn, catalogID, del, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionsBatchRead(ctx, d, meta)...)
+}
+
+func resourcePermissionsBatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	entries, err := expandPermissionsBatchEntries(d.Get("entry").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+	}
+
+	if err := batchRevokePermissions(ctx, conn, d.Get("catalog_id").(string), entries, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Permissions Batch (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// permissionsBatchDiff groups entries by principal and resource and returns the
+// entries granting permissions only in n and revoking permissions only in o.
+// Editing a set element shows up as removing the old entry and adding the new one,
+// so comparing whole entries would revoke permissions that are kept.
+func permissionsBatchDiff(o, n []*lakeformation.BatchPermissionsRequestEntry) ([]*lakeformation.BatchPermissionsRequestEntry, []*lakeformation.BatchPermissionsRequestEntry) {
+	type permissionsBatchGroup struct {
+		entry                      *lakeformation.BatchPermissionsRequestEntry
+		permissions                map[string]struct{}
+		permissionsWithGrantOption map[string]struct{}
+	}
+
+	group := func(entries []*lakeformation.BatchPermissionsRequestEntry) ([]string, map[string]*permissionsBatchGroup) {
+		var keys []string
+		groups := make(map[string]*permissionsBatchGroup)
+
+		for _, v := range entries {
+			key := permissionsBatchKey(aws.StringValue(v.Principal.DataLakePrincipalIdentifier), v.Resource)
+
+			g, ok := groups[key]
+			if !ok {
+				g = &permissionsBatchGroup{
+					entry:                      v,
+					permissions:                make(map[string]struct{}),
+					permissionsWithGrantOption: make(map[string]struct{}),
+				}
+				groups[key] = g
+				keys = append(keys, key)
+			}
+
+			for _, p := range aws.StringValueSlice(v.Permissions) {
+				g.permissions[p] = struct{}{}
+			}
+
+			for _, p := range aws.StringValueSlice(v.PermissionsWithGrantOption) {
+				g.permissionsWithGrantOption[p] = struct{}{}
+			}
+		}
+
+		return keys, groups
+	}
+
+	difference := func(a, b map[string]struct{}) []string {
+		var s []string
+
+		for k := range a {
+			if _, ok := b[k]; !ok {
+				s = append(s, k)
+			}
+		}
+
+		sort.Strings(s)
+
+		return s
+	}
+
+	entries := func(keys []string, fromGroups, toGroups map[string]*permissionsBatchGroup) []*lakeformation.BatchPermissionsRequestEntry {
+		var apiObjects []*lakeformation.BatchPermissionsRequestEntry
+
+		for _, key := range keys {
+			g := fromGroups[key]
+			other, ok := toGroups[key]
+			if !ok {
+				other = &permissionsBatchGroup{}
+			}
+
+			permissions := difference(g.permissions, other.permissions)
+			permissionsWithGrantOption := difference(g.permissionsWithGrantOption, other.permissionsWithGrantOption)
+
+			if len(permissions) == 0 && len(permissionsWithGrantOption) == 0 {
+				continue
+			}
+
+			apiObject := &lakeformation.BatchPermissionsRequestEntry{
+				Id:          aws.String(strconv.Itoa(len(apiObjects))),
+				Permissions: aws.StringSlice(permissions),
+				Principal:   g.entry.Principal,
+				Resource:    g.entry.Resource,
+			}
+
+			if len(permissionsWithGrantOption) > 0 {
+				apiObject.PermissionsWithGrantOption = aws.StringSlice(permissionsWithGrantOption)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+
+		return apiObjects
+	}
+
+	oldKeys, oldGroups := group(o)
+	newKeys, newGroups := group(n)
+
+	return entries(newKeys, newGroups, oldGroups), entries(oldKeys, oldGroups, newGroups)
+}
+
+func batchGrantPermissions(ctx context.Context, conn *lakeformation.LakeFormation, catalogID string, entries []*lakeformation.BatchPermissionsRequestEntry, timeout time.Duration) error {
+	for _, chunk := range chunkPermissionsBatchEntries(entries) {
+		input := &lakeformation.BatchGrantPermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			return conn.BatchGrantPermissionsWithContext(ctx, input)
+		}, lakeformation.ErrCodeConcurrentModificationException)
+
+		if err != nil {
+			return err
+		}
+
+		if err := permissionsBatchFailuresError(outputRaw.(*lakeformation.BatchGrantPermissionsOutput).Failures, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func batchRevokePermissions(ctx context.Context, conn *lakeformation.LakeFormation, catalogID string, entries []*lakeformation.BatchPermissionsRequestEntry, timeout time.Duration) error {
+	for _, chunk := range chunkPermissionsBatchEntries(entries) {
+		input := &lakeformation.BatchRevokePermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			return conn.BatchRevokePermissionsWithContext(ctx, input)
+		}, lakeformation.ErrCodeConcurrentModificationException)
+
+		if err != nil {
+			return err
+		}
+
+		// Permissions which have already been revoked are not an error.
+		ignore := func(v *lakeformation.ErrorDetail) bool {
+			return strings.Contains(aws.StringValue(v.ErrorMessage), "No permissions revoked")
+		}
+
+		if err := permissionsBatchFailuresError(outputRaw.(*lakeformation.BatchRevokePermissionsOutput).Failures, ignore); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func chunkPermissionsBatchEntries(entries []*lakeformation.BatchPermissionsRequestEntry) [][]*lakeformation.BatchPermissionsRequestEntry {
+	var chunks [][]*lakeformation.BatchPermissionsRequestEntry
+
+	for i := 0; i < len(entries); i += permissionsBatchMaxEntries {
+		end := i + permissionsBatchMaxEntries
+
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		chunks = append(chunks, entries[i:end])
+	}
+
+	return chunks
+}
+
+func permissionsBatchFailuresError(failures []*lakeformation.BatchPermissionsFailureEntry, ignore func(*lakeformation.ErrorDetail) bool) error {
+	var errs *multierror.Error
+
+	for _, v := range failures {
+		if v == nil || v.Error == nil {
+			continue
+		}
+
+		if ignore != nil && ignore(v.Error) {
+			continue
+		}
+
+		principal := ""
+		if v.RequestEntry != nil && v.RequestEntry.Principal != nil {
+			principal = aws.StringValue(v.RequestEntry.Principal.DataLakePrincipalIdentifier)
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("principal (%s): %s: %s", principal, aws.StringValue(v.Error.ErrorCode), aws.StringValue(v.Error.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func expandPermissionsBatchEntries(tfList []interface{}) ([]*lakeformation.BatchPermissionsRequestEntry, error) {
+	apiObjects := make([]*lakeformation.BatchPermissionsRequestEntry, 0, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject, err := expandPermissionsBatchEntry(tfMap)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.Id = aws.String(strconv.Itoa(i))
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandPermissionsBatchEntry(tfMap map[string]interface{}) (*lakeformation.BatchPermissionsRequestEntry, error) {
+	apiObject := &lakeformation.BatchPermissionsRequestEntry{
+		Permissions: flex.ExpandStringSet(tfMap["permissions"].(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(tfMap["principal"].(string)),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PermissionsWithGrantOption = flex.ExpandStringSet(v)
+	}
+
+	n := 0
+
+	if v, ok := tfMap["catalog_resource"].(bool); ok && v {
+		apiObject.Resource.Catalog = ExpandCatalogResource()
+		n++
+	}
+
+	if v, ok := tfMap["data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.DataLocation = ExpandDataLocationResource(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if v, ok := tfMap["database"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.Database = ExpandDatabaseResource(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if v, ok := tfMap["lf_tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.LFTag = ExpandLFTagKeyResource(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if v, ok := tfMap["lf_tag_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.LFTagPolicy = ExpandLFTagPolicyResource(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if v, ok := tfMap["table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfTableMap := v[0].(map[string]interface{})
+
+		if tfTableMap["name"].(string) == "" && !tfTableMap["wildcard"].(bool) {
+			return nil, fmt.Errorf("one of table name or wildcard must be set for principal (%s)", tfMap["principal"])
+		}
+
+		apiObject.Resource.Table = ExpandTableResource(tfTableMap)
+		n++
+	}
+
+	if v, ok := tfMap["table_with_columns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.TableWithColumns = expandTableColumnsResource(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("exactly one of catalog_resource, data_location, database, lf_tag, lf_tag_policy, table or table_with_columns must be set for principal (%s)", tfMap["principal"])
+	}
+
+	return apiObject, nil
+}
+
+// permissionsBatchKey returns a canonical key for a principal and resource,
+// ignoring catalog IDs and the different ways Lake Formation can represent
+// equivalent table resources.
+func permissionsBatchKey(principal string, resource *lakeformation.Resource) string {
+	var key string
+
+	switch {
+	case resource.Catalog != nil:
+		key = "catalog"
+	case resource.DataLocation != nil:
+		key = "data_location|" + aws.StringValue(resource.DataLocation.ResourceArn)
+	case resource.Database != nil:
+		key = "database|" + aws.StringValue(resource.Database.Name)
+	case resource.LFTag != nil:
+		values := aws.StringValueSlice(resource.LFTag.TagValues)
+		sort.Strings(values)
+		key = "lf_tag|" + aws.StringValue(resource.LFTag.TagKey) + "|" + strings.Join(values, ",")
+	case resource.LFTagPolicy != nil:
+		var expression []string
+		for _, v := range resource.LFTagPolicy.Expression {
+			values := aws.StringValueSlice(v.TagValues)
+			sort.Strings(values)
+			expression = append(expression, aws.StringValue(v.TagKey)+"="+strings.Join(values, ","))
+		}
+		sort.Strings(expression)
+		key = "lf_tag_policy|" + aws.StringValue(resource.LFTagPolicy.ResourceType) + "|" + strings.Join(expression, ";")
+	case resource.Table != nil:
+		name := aws.StringValue(resource.Table.Name)
+		if resource.Table.TableWildcard != nil {
+			name = TableNameAllTables
+		}
+		key = "table|" + aws.StringValue(resource.Table.DatabaseName) + "|" + name
+	case resource.TableWithColumns != nil:
+		twc := resource.TableWithColumns
+		name := aws.StringValue(twc.Name)
+
+		// A column wildcard without exclusions is equivalent to the table itself.
+		if twc.ColumnWildcard != nil && len(twc.ColumnWildcard.ExcludedColumnNames) == 0 {
+			key = "table|" + aws.StringValue(twc.DatabaseName) + "|" + name
+			break
+		}
+
+		var columns []string
+		if twc.ColumnWildcard != nil {
+			columns = aws.StringValueSlice(twc.ColumnWildcard.ExcludedColumnNames)
+			name += "|excluded"
+		} else {
+			columns = aws.StringValueSlice(twc.ColumnNames)
+		}
+		sort.Strings(columns)
+		key = "table_with_columns|" + aws.StringValue(twc.DatabaseName) + "|" + name + "|" + strings.Join(columns, ",")
+	}
+
+	return principal + "|" + key
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccPermissionsBatch_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsBatchDestroy(ctx, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+				),
+			},
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 25),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(ctx, rName, 25),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "25"),
+				),
+			},
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionsBatch_updateEntry(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsBatchDestroy(ctx, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBatchConfig_permissions(rName, `"ALTER", "DESCRIBE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchDatabasePermissions(ctx, rName, "ALTER", "DESCRIBE"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.permissions.#", "2"),
+				),
+			},
+			{
+				Config: testAccPermissionsBatchConfig_permissions(rName, `"ALTER", "DESCRIBE", "DROP"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchDatabasePermissions(ctx, rName, "ALTER", "DESCRIBE", "DROP"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.permissions.#", "3"),
+				),
+			},
+			{
+				Config: testAccPermissionsBatchConfig_permissions(rName, `"DESCRIBE", "DROP"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchDatabasePermissions(ctx, rName, "DESCRIBE", "DROP"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.permissions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsBatchDestroy(ctx context.Context, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_permissions_batch" {
+				continue
+			}
+
+			count, err := permissionsBatchDatabaseCount(ctx, conn, rName)
+
+			if err != nil {
+				return fmt.Errorf("acceptance test: error listing Lake Formation permissions (%s): %w", rs.Primary.ID, err)
+			}
+
+			if count != 0 {
+				return fmt.Errorf("acceptance test: Lake Formation Permissions Batch (%s) still exists: %d", rs.Primary.ID, count)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionsBatchExists(ctx context.Context, rName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		count, err := permissionsBatchDatabaseCount(ctx, conn, rName)
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions: %w", err)
+		}
+
+		if count != expected {
+			return fmt.Errorf("acceptance test: expected %d Lake Formation database permissions, got %d", expected, count)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckPermissionsBatchDatabasePermissions checks that the test role holds exactly
+// the expected permissions on the first database named after rName.
+func testAccCheckPermissionsBatchDatabasePermissions(ctx context.Context, rName string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		var permissions []string
+
+		err := conn.ListPermissionsPagesWithContext(ctx, &lakeformation.ListPermissionsInput{
+			Resource: &lakeformation.Resource{
+				Database: &lakeformation.DatabaseResource{
+					Name: aws.String(rName + "-0"),
+				},
+			},
+		}, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.PrincipalResourcePermissions {
+				if v.Principal == nil || !strings.HasSuffix(aws.StringValue(v.Principal.DataLakePrincipalIdentifier), "/"+rName) {
+					continue
+				}
+
+				permissions = append(permissions, aws.StringValueSlice(v.Permissions)...)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions: %w", err)
+		}
+
+		sort.Strings(permissions)
+		sort.Strings(expected)
+
+		if !reflect.DeepEqual(permissions, expected) {
+			return fmt.Errorf("acceptance test: expected Lake Formation database permissions %v, got %v", expected, permissions)
+		}
+
+		return nil
+	}
+}
+
+// permissionsBatchDatabaseCount returns the number of databases named after rName
+// on which the test role holds permissions.
+func permissionsBatchDatabaseCount(ctx context.Context, conn *lakeformation.LakeFormation, rName string) (int, error) {
+	databases := make(map[string]struct{})
+
+	err := conn.ListPermissionsPagesWithContext(ctx, &lakeformation.ListPermissionsInput{
+		ResourceType: aws.String(lakeformation.DataLakeResourceTypeDatabase),
+	}, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v.Resource == nil || v.Resource.Database == nil || v.Principal == nil {
+				continue
+			}
+
+			if name := aws.StringValue(v.Resource.Database.Name); len(name) > len(rName) && name[:len(rName)] == rName {
+				databases[name] = struct{}{}
+			}
+		}
+
+		return !lastPage
+	})
+
+	return len(databases), err
+}
+
+func testAccPermissionsBatchConfig_basic(rName string, n int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  count = 25
+
+  name = "%[1]s-${count.index}"
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_batch" "test" {
+  dynamic "entry" {
+    for_each = slice(aws_glue_catalog_database.test[*].name, 0, %[2]d)
+
+    content {
+      permissions = ["ALTER", "DESCRIBE"]
+      principal   = aws_iam_role.test.arn
+
+      database {
+        name = entry.value
+      }
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, n)
+}
+
+func testAccPermissionsBatchConfig_permissions(rName, permissions string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = "%[1]s-0"
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_batch" "test" {
+  entry {
+    permissions = [%[2]s]
+    principal   = aws_iam_role.test.arn
+
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, permissions)
+}
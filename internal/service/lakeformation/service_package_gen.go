@@ -54,6 +54,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
 		},
+		{
+			Factory:  ResourcePermissionsBatch,
+			TypeName: "aws_lakeformation_permissions_batch",
+			Name:     "Permissions Batch",
+		},
 		{
 			Factory:  ResourceResource,
 			TypeName: "aws_lakeformation_resource",
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_location_key", name="Key")
+// @Tags(identifierAttribute="key_arn")
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"expire_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+				ExactlyOneOf: []string{"expire_time", "no_expiry"},
+			},
+			"force_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"no_expiry": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ExactlyOneOf: []string{"expire_time", "no_expiry"},
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_referers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_resources": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	name := d.Get("key_name").(string)
+	input := &locationservice.CreateKeyInput{
+		KeyName:      aws.String(name),
+		Restrictions: expandAPIKeyRestrictions(d.Get("restrictions").([]interface{})[0].(map[string]interface{})),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expire_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpireTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("no_expiry"); ok {
+		input.NoExpiry = aws.Bool(v.(bool))
+	}
+
+	output, err := conn.CreateKeyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Location Service Key (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.KeyName))
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	input := &locationservice.DescribeKeyInput{
+		KeyName: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeKeyWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Location Service Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Location Service Key (%s): %s", d.Id(), err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Location Service Key (%s): empty response", d.Id())
+	}
+
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	// A key without expiry reports a far-future expiration time.
+	if !d.Get("no_expiry").(bool) {
+		d.Set("expire_time", aws.TimeValue(output.ExpireTime).Format(time.RFC3339))
+	}
+	d.Set("key", output.Key)
+	d.Set("key_arn", output.KeyArn)
+	d.Set("key_name", output.KeyName)
+	if err := d.Set("restrictions", []interface{}{flattenAPIKeyRestrictions(output.Restrictions)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting restrictions: %s", err)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	if d.HasChanges("description", "expire_time", "no_expiry", "restrictions") {
+		input := &locationservice.UpdateKeyInput{
+			ForceUpdate: aws.Bool(d.Get("force_update").(bool)),
+			KeyName:     aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChanges("expire_time", "no_expiry") {
+			if v, ok := d.GetOk("expire_time"); ok {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+				input.ExpireTime = aws.Time(v)
+			}
+
+			if v, ok := d.GetOk("no_expiry"); ok {
+				input.NoExpiry = aws.Bool(v.(bool))
+			}
+		}
+
+		if d.HasChange("restrictions") {
+			input.Restrictions = expandAPIKeyRestrictions(d.Get("restrictions").([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateKeyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Location Service Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	// An API key can only be deleted 90 days after it has been deactivated.
+	// Deactivate the key now so that it can no longer be used.
+	_, err := conn.UpdateKeyWithContext(ctx, &locationservice.UpdateKeyInput{
+		ExpireTime:  aws.Time(time.Now()),
+		ForceUpdate: aws.Bool(true),
+		KeyName:     aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deactivating Location Service Key (%s): %s", d.Id(), err)
+	}
+
+	_, err = conn.DeleteKeyWithContext(ctx, &locationservice.DeleteKeyInput{
+		KeyName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeValidationException) {
+		log.Printf("[WARN] Location Service Key (%s) deactivated, but not deleted: %s", d.Id(), err)
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Location Service Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandAPIKeyRestrictions(tfMap map[string]interface{}) *locationservice.ApiKeyRestrictions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.ApiKeyRestrictions{}
+
+	if v, ok := tfMap["allow_actions"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowActions = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["allow_referers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowReferers = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["allow_resources"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowResources = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenAPIKeyRestrictions(apiObject *locationservice.ApiKeyRestrictions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_actions":   aws.StringValueSlice(apiObject.AllowActions),
+		"allow_referers":  aws.StringValueSlice(apiObject.AllowReferers),
+		"allow_resources": aws.StringValueSlice(apiObject.AllowResources),
+	}
+
+	return tfMap
+}
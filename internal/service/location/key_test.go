@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccLocationKey_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "key_arn", "geo", fmt.Sprintf("api-key/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "key_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "no_expiry", "true"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.0", "geo:GetMap*"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_resources.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "restrictions.0.allow_resources.0", "aws_location_map.test", "map_arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_update", "no_expiry"},
+			},
+		},
+	})
+}
+
+func TestAccLocationKey_restrictions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "0"),
+				),
+			},
+			{
+				Config: testAccKeyConfig_restrictions(rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.0", "geo:GetMap*"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.1", "geo:SearchPlaceIndexForText"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.0", "https://example.com/*"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_resources.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_key" {
+				continue
+			}
+
+			input := &locationservice.DescribeKeyInput{
+				KeyName: aws.String(rs.Primary.ID),
+			}
+
+			output, err := conn.DescribeKeyWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error getting Location Service Key (%s): %w", rs.Primary.ID, err)
+			}
+
+			// Keys can only be deleted 90 days after deactivation.
+			if output != nil && aws.TimeValue(output.ExpireTime).After(time.Now()) {
+				return fmt.Errorf("Location Service Key (%s) still active", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		input := &locationservice.DescribeKeyInput{
+			KeyName: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.DescribeKeyWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error getting Location Service Key (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccKeyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_map" "test" {
+  configuration {
+    style = "VectorHereBerlin"
+  }
+
+  map_name = %[1]q
+}
+
+resource "aws_location_place_index" "test" {
+  data_source = "Here"
+  index_name  = %[1]q
+}
+`, rName)
+}
+
+func testAccKeyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_key" "test" {
+  key_name  = %[1]q
+  no_expiry = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+}
+`, rName))
+}
+
+func testAccKeyConfig_restrictions(rName, description string) string {
+	return acctest.ConfigCompose(testAccKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_key" "test" {
+  description  = %[2]q
+  force_update = true
+  key_name     = %[1]q
+  no_expiry    = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*", "geo:SearchPlaceIndexForText"]
+    allow_referers  = ["https://example.com/*"]
+    allow_resources = [aws_location_map.test.map_arn, aws_location_place_index.test.index_arn]
+  }
+}
+`, rName, description))
+}
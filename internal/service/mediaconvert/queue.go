@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -82,8 +83,9 @@ func ResourceQueue() *schema.Resource {
 							}, false),
 						},
 						"reserved_slots": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -101,7 +103,10 @@ func ResourceQueue() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceQueueCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -188,17 +193,16 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChanges("description", "reservation_plan_settings", "status") {
 		updateOpts := &mediaconvert.UpdateQueueInput{
-			Name:   aws.String(d.Id()),
-			Status: aws.String(d.Get("status").(string)),
-		}
-
-		if v, ok := d.GetOk("description"); ok {
-			updateOpts.Description = aws.String(v.(string))
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Status:      aws.String(d.Get("status").(string)),
 		}
 
-		if v, ok := d.GetOk("reservation_plan_settings"); ok {
-			reservationPlanSettings := v.([]interface{})[0].(map[string]interface{})
-			updateOpts.ReservationPlanSettings = expandReservationPlanSettings(reservationPlanSettings)
+		// Sending the reservation plan settings purchases a new commitment, so only do so when they change.
+		if d.HasChange("reservation_plan_settings") {
+			if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				updateOpts.ReservationPlanSettings = expandReservationPlanSettings(v[0].(map[string]interface{}))
+			}
 		}
 
 		_, err = conn.UpdateQueueWithContext(ctx, updateOpts)
@@ -239,6 +243,32 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("pricing_plan").(string) != mediaconvert.PricingPlanReserved || !diff.HasChange("reservation_plan_settings") {
+		return nil
+	}
+
+	o, n := diff.GetChange("reservation_plan_settings")
+	oldSettings, newSettings := o.([]interface{}), n.([]interface{})
+
+	if len(oldSettings) == 0 || oldSettings[0] == nil {
+		return nil
+	}
+
+	if len(newSettings) == 0 || newSettings[0] == nil {
+		return fmt.Errorf("reservation_plan_settings cannot be removed from a %s queue", mediaconvert.PricingPlanReserved)
+	}
+
+	oldSlots := oldSettings[0].(map[string]interface{})["reserved_slots"].(int)
+	newSlots := newSettings[0].(map[string]interface{})["reserved_slots"].(int)
+
+	if newSlots < oldSlots {
+		return fmt.Errorf("reservation_plan_settings.0.reserved_slots cannot be decreased (%d to %d) during the commitment term", oldSlots, newSlots)
+	}
+
+	return nil
+}
+
 func GetAccountClient(ctx context.Context, awsClient *conns.AWSClient) (*mediaconvert.MediaConvert, error) {
 	const mutexKey = `mediaconvertaccountconn`
 	conns.GlobalMutexKV.Lock(mutexKey)
@@ -29,6 +29,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceJobTemplate,
+			TypeName: "aws_media_convert_job_template",
+			Name:     "Job Template",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  ResourcePreset,
+			TypeName: "aws_media_convert_preset",
+			Name:     "Preset",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  ResourceQueue,
 			TypeName: "aws_media_convert_queue",
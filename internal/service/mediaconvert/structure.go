@@ -4,7 +4,11 @@
 package mediaconvert
 
 import (
+	"encoding/json"
+	"reflect"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 )
 
@@ -39,3 +43,148 @@ func flattenReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []int
 
 	return []interface{}{m}
 }
+
+// settingsJSONEquivalent reports whether the settings JSON returned by the API
+// (old) satisfies the configured settings JSON (new). MediaConvert fills in
+// defaults for omitted settings, so only the values present in the
+// configuration are compared. Both documents are first normalized through the
+// API type so that differences in key casing are ignored.
+func settingsJSONEquivalent(old, new string, newAPIObject func() interface{}) (bool, error) {
+	normalize := func(s string) (interface{}, error) {
+		apiObject := newAPIObject()
+
+		if err := json.Unmarshal([]byte(s), apiObject); err != nil {
+			return nil, err
+		}
+
+		b, err := jsonutil.BuildJSON(apiObject)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var v interface{}
+
+		if err := json.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+
+	o, err := normalize(old)
+
+	if err != nil {
+		return false, err
+	}
+
+	n, err := normalize(new)
+
+	if err != nil {
+		return false, err
+	}
+
+	return jsonSubset(n, o), nil
+}
+
+// jsonSubset reports whether every value present in a is also present in b.
+func jsonSubset(a, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for k, v := range a {
+			if !jsonSubset(v, b[k]) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !jsonSubset(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func expandAccelerationSettings(tfMap map[string]interface{}) *mediaconvert.AccelerationSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconvert.AccelerationSettings{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccelerationSettings(apiObject *mediaconvert.AccelerationSettings) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"mode": aws.StringValue(apiObject.Mode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandHopDestinations(tfList []interface{}) []*mediaconvert.HopDestination {
+	var apiObjects []*mediaconvert.HopDestination
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconvert.HopDestination{
+			Priority:    aws.Int64(int64(tfMap["priority"].(int))),
+			WaitMinutes: aws.Int64(int64(tfMap["wait_minutes"].(int))),
+		}
+
+		if v, ok := tfMap["queue"].(string); ok && v != "" {
+			apiObject.Queue = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenHopDestinations(apiObjects []*mediaconvert.HopDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"priority":     aws.Int64Value(apiObject.Priority),
+			"queue":        aws.StringValue(apiObject.Queue),
+			"wait_minutes": aws.Int64Value(apiObject.WaitMinutes),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+)
+
+func TestSettingsJSONEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			old:      `{"containerSettings":{"container":"MP4"}}`,
+			new:      `{"containerSettings":{"container":"MP4"}}`,
+			expected: true,
+		},
+		{
+			name:     "key casing",
+			old:      `{"containerSettings":{"container":"MP4"}}`,
+			new:      `{"ContainerSettings":{"Container":"MP4"}}`,
+			expected: true,
+		},
+		{
+			name:     "API defaults",
+			old:      `{"containerSettings":{"container":"MP4","mp4Settings":{"moovPlacement":"PROGRESSIVE_DOWNLOAD"}}}`,
+			new:      `{"ContainerSettings":{"Container":"MP4"}}`,
+			expected: true,
+		},
+		{
+			name:     "changed value",
+			old:      `{"containerSettings":{"container":"MP4"}}`,
+			new:      `{"ContainerSettings":{"Container":"MOV"}}`,
+			expected: false,
+		},
+		{
+			name:     "added value",
+			old:      `{"containerSettings":{"container":"MP4"}}`,
+			new:      `{"ContainerSettings":{"Container":"MP4"},"VideoDescription":{"Width":1280}}`,
+			expected: false,
+		},
+		{
+			name:     "list length",
+			old:      `{"audioDescriptions":[{"audioSourceName":"Audio Selector 1"}]}`,
+			new:      `{"AudioDescriptions":[{"AudioSourceName":"Audio Selector 1"},{"AudioSourceName":"Audio Selector 2"}]}`,
+			expected: false,
+		},
+		{
+			name:     "no old value",
+			old:      ``,
+			new:      `{"ContainerSettings":{"Container":"MP4"}}`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := settingsJSONEquivalent(testCase.old, testCase.new, func() interface{} { return &mediaconvert.PresetSettings{} })
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
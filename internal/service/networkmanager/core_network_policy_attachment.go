@@ -5,11 +5,15 @@ package networkmanager
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -28,7 +32,11 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 		DeleteWithoutTimeout: schema.NoopContext,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("execute_change_set", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -36,6 +44,10 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"change_set_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"core_network_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -45,6 +57,11 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^core-network-([0-9a-f]{8,17})$`), "must be a valid Core Network ID"),
 				),
 			},
+			"execute_change_set": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"policy_document": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -95,6 +112,7 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 	coreNetworkPolicy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), latestPolicyVersionID)
 
 	if tfresource.NotFound(err) {
+		d.Set("change_set_state", nil)
 		d.Set("policy_document", nil)
 	} else if err != nil {
 		return diag.Errorf("reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
@@ -105,25 +123,81 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 			return diag.Errorf("encoding Network Manager Core Network (%s) policy document: %s", d.Id(), err)
 		}
 
+		d.Set("change_set_state", coreNetworkPolicy.ChangeSetState)
 		d.Set("policy_document", encodedPolicyDocument)
 	}
 	return nil
 }
 
 func resourceCoreNetworkPolicyAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkManagerConn(ctx)
 
+	var policyVersionID int64
+
 	if d.HasChange("policy_document") {
-		err := PutAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string))
+		v, err := putCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string))
 
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
+		policyVersionID = v
+
+		changes, err := FindCoreNetworkChangesByTwoPartKey(ctx, conn, d.Id(), policyVersionID)
+
+		if err != nil {
+			return diag.Errorf("reading Network Manager Core Network (%s) change set (%d): %s", d.Id(), policyVersionID, err)
+		}
+
+		// The change set only exists once the policy version has been put, so it is summarized at apply time, not plan time.
+		// With execute_change_set = false the summary can be reviewed before the change set is executed.
+		if len(changes) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Network Manager Core Network (%s) change set (%d) summary", d.Id(), policyVersionID),
+				Detail:   coreNetworkChangesSummary(changes),
+			})
+		}
+	} else if d.HasChange("execute_change_set") {
+		// Execute a change set left pending by a previous apply.
+		policy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), latestPolicyVersionID)
+
+		if err != nil {
+			return diag.Errorf("reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
+		}
+
+		if aws.StringValue(policy.ChangeSetState) == networkmanager.ChangeSetStateReadyToExecute {
+			policyVersionID = aws.Int64Value(policy.PolicyVersionId)
+		}
+	}
+
+	if policyVersionID >= minimumValidPolicyVersionID && d.Get("execute_change_set").(bool) {
+		if err := executeCoreNetworkChangeSet(ctx, conn, d.Id(), policyVersionID); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
 		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return diag.Errorf("waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
+			return append(diags, diag.Errorf("waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)...)
 		}
 	}
 
-	return resourceCoreNetworkPolicyAttachmentRead(ctx, d, meta)
+	return append(diags, resourceCoreNetworkPolicyAttachmentRead(ctx, d, meta)...)
+}
+
+// coreNetworkChangesSummary returns a human-readable, one line per change summary of a core network change set.
+func coreNetworkChangesSummary(apiObjects []*networkmanager.CoreNetworkChange) string {
+	var lines []string
+
+	for _, apiObject := range apiObjects {
+		line := fmt.Sprintf("%s %s %s", aws.StringValue(apiObject.Action), aws.StringValue(apiObject.Type), aws.StringValue(apiObject.Identifier))
+
+		if v := aws.StringValue(apiObject.IdentifierPath); v != "" {
+			line = fmt.Sprintf("%s (%s)", line, v)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
 }
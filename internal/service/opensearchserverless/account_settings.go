@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Account Settings")
+func newResourceAccountSettings(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAccountSettings{}, nil
+}
+
+const (
+	ResNameAccountSettings = "Account Settings"
+)
+
+type resourceAccountSettings struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAccountSettings) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_opensearchserverless_account_settings"
+}
+
+func (r *resourceAccountSettings) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_limits": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"max_indexing_capacity_in_ocu": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2),
+						},
+					},
+					"max_search_capacity_in_ocu": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAccountSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceAccountSettingsData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	in := &opensearchserverless.UpdateAccountSettingsInput{
+		CapacityLimits: expandCapacityLimits(ctx, plan.CapacityLimits, &resp.Diagnostics),
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.UpdateAccountSettings(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameAccountSettings, r.Meta().AccountID, nil),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = types.StringValue(r.Meta().AccountID)
+	state.refreshFromOutput(ctx, out.AccountSettingsDetail)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAccountSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var state resourceAccountSettingsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findAccountSettings(ctx, conn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameAccountSettings, state.ID.ValueString(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAccountSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	var plan, state resourceAccountSettingsData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CapacityLimits.Equal(state.CapacityLimits) {
+		input := &opensearchserverless.UpdateAccountSettingsInput{
+			CapacityLimits: expandCapacityLimits(ctx, plan.CapacityLimits, &resp.Diagnostics),
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		out, err := conn.UpdateAccountSettings(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionUpdating, ResNameAccountSettings, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.refreshFromOutput(ctx, out.AccountSettingsDetail)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the account settings from state only. OpenSearch Serverless
+// has no API to reset capacity limits to their defaults.
+func (r *resourceAccountSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *resourceAccountSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Read leaves a null capacity_limits null, so start from an empty object to have it populated on import.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("capacity_limits"), flattenCapacityLimits(ctx, &awstypes.CapacityLimits{}))...)
+}
+
+type resourceAccountSettingsData struct {
+	ID             types.String `tfsdk:"id"`
+	CapacityLimits types.Object `tfsdk:"capacity_limits"`
+}
+
+// refreshFromOutput writes state data from an AWS response object.
+// An omitted capacity_limits block is left null rather than populated with the account defaults.
+func (rd *resourceAccountSettingsData) refreshFromOutput(ctx context.Context, out *awstypes.AccountSettingsDetail) {
+	if out == nil || rd.CapacityLimits.IsNull() {
+		return
+	}
+
+	rd.CapacityLimits = flattenCapacityLimits(ctx, out.CapacityLimits)
+}
+
+type capacityLimits struct {
+	MaxIndexingCapacityInOCU types.Int64 `tfsdk:"max_indexing_capacity_in_ocu"`
+	MaxSearchCapacityInOCU   types.Int64 `tfsdk:"max_search_capacity_in_ocu"`
+}
+
+func (cl *capacityLimits) expand(ctx context.Context) *awstypes.CapacityLimits {
+	if cl == nil {
+		return nil
+	}
+
+	result := &awstypes.CapacityLimits{}
+
+	if v := cl.MaxIndexingCapacityInOCU.ValueInt64(); v != 0 {
+		result.MaxIndexingCapacityInOCU = aws.Int32(int32(v))
+	}
+
+	if v := cl.MaxSearchCapacityInOCU.ValueInt64(); v != 0 {
+		result.MaxSearchCapacityInOCU = aws.Int32(int32(v))
+	}
+
+	return result
+}
+
+func expandCapacityLimits(ctx context.Context, object types.Object, diags *diag.Diagnostics) *awstypes.CapacityLimits {
+	if object.IsNull() || object.IsUnknown() {
+		return nil
+	}
+
+	var limits capacityLimits
+	diags.Append(object.As(ctx, &limits, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return limits.expand(ctx)
+}
+
+func flattenCapacityLimits(ctx context.Context, cl *awstypes.CapacityLimits) types.Object {
+	attributeTypes := flex.AttributeTypesMust[capacityLimits](ctx)
+
+	if cl == nil {
+		return types.ObjectNull(attributeTypes)
+	}
+
+	attrs := map[string]attr.Value{}
+	attrs["max_indexing_capacity_in_ocu"] = types.Int64Null()
+	if cl.MaxIndexingCapacityInOCU != nil {
+		attrs["max_indexing_capacity_in_ocu"] = types.Int64Value(int64(aws.ToInt32(cl.MaxIndexingCapacityInOCU)))
+	}
+	attrs["max_search_capacity_in_ocu"] = types.Int64Null()
+	if cl.MaxSearchCapacityInOCU != nil {
+		attrs["max_search_capacity_in_ocu"] = types.Int64Value(int64(aws.ToInt32(cl.MaxSearchCapacityInOCU)))
+	}
+
+	return types.ObjectValueMust(attributeTypes, attrs)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessAccountSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsettings types.AccountSettingsDetail
+	resourceName := "aws_opensearchserverless_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_basic(4, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName, &accountsettings),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.max_indexing_capacity_in_ocu", "4"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.max_search_capacity_in_ocu", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingsConfig_basic(6, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName, &accountsettings),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.max_indexing_capacity_in_ocu", "6"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.max_search_capacity_in_ocu", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessAccountSettings_noCapacityLimits(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsettings types.AccountSettingsDetail
+	resourceName := "aws_opensearchserverless_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_noCapacityLimits(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName, &accountsettings),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "capacity_limits.max_indexing_capacity_in_ocu"),
+					resource.TestCheckNoResourceAttr(resourceName, "capacity_limits.max_search_capacity_in_ocu"),
+				),
+			},
+			{
+				Config: testAccAccountSettingsConfig_basic(4, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName, &accountsettings),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.max_indexing_capacity_in_ocu", "4"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.max_search_capacity_in_ocu", "4"),
+				),
+			},
+			{
+				Config: testAccAccountSettingsConfig_noCapacityLimits(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName, &accountsettings),
+					resource.TestCheckNoResourceAttr(resourceName, "capacity_limits.max_indexing_capacity_in_ocu"),
+					resource.TestCheckNoResourceAttr(resourceName, "capacity_limits.max_search_capacity_in_ocu"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingsExists(ctx context.Context, name string, accountsettings *types.AccountSettingsDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameAccountSettings, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameAccountSettings, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
+		resp, err := tfopensearchserverless.FindAccountSettings(ctx, conn)
+
+		if err != nil {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameAccountSettings, rs.Primary.ID, err)
+		}
+
+		*accountsettings = *resp
+
+		return nil
+	}
+}
+
+func testAccAccountSettingsConfig_basic(maxIndexing, maxSearch int) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_account_settings" "test" {
+  capacity_limits {
+    max_indexing_capacity_in_ocu = %[1]d
+    max_search_capacity_in_ocu   = %[2]d
+  }
+}
+`, maxIndexing, maxSearch)
+}
+
+func testAccAccountSettingsConfig_noCapacityLimits() string {
+	return `
+resource "aws_opensearchserverless_account_settings" "test" {}
+`
+}
@@ -5,13 +5,15 @@ package opensearchserverless
 
 // Exports for use in tests only.
 var (
-	ResourceAccessPolicy   = newResourceAccessPolicy
-	ResourceCollection     = newResourceCollection
-	ResourceSecurityConfig = newResourceSecurityConfig
-	ResourceSecurityPolicy = newResourceSecurityPolicy
-	ResourceVPCEndpoint    = newResourceVPCEndpoint
+	ResourceAccessPolicy    = newResourceAccessPolicy
+	ResourceAccountSettings = newResourceAccountSettings
+	ResourceCollection      = newResourceCollection
+	ResourceSecurityConfig  = newResourceSecurityConfig
+	ResourceSecurityPolicy  = newResourceSecurityPolicy
+	ResourceVPCEndpoint     = newResourceVPCEndpoint
 
 	FindAccessPolicyByNameAndType   = findAccessPolicyByNameAndType
+	FindAccountSettings             = findAccountSettings
 	FindCollectionByID              = findCollectionByID
 	FindSecurityConfigByID          = findSecurityConfigByID
 	FindSecurityPolicyByNameAndType = findSecurityPolicyByNameAndType
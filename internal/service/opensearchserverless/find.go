@@ -164,3 +164,18 @@ func findVPCEndpointByID(ctx context.Context, conn *opensearchserverless.Client,
 
 	return &out.VpcEndpointDetails[0], nil
 }
+
+func findAccountSettings(ctx context.Context, conn *opensearchserverless.Client) (*types.AccountSettingsDetail, error) {
+	in := &opensearchserverless.GetAccountSettingsInput{}
+	out, err := conn.GetAccountSettings(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AccountSettingsDetail == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AccountSettingsDetail, nil
+}
@@ -36,6 +36,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceAccessPolicy,
 		},
+		{
+			Factory: newResourceAccountSettings,
+			Name:    "Account Settings",
+		},
 		{
 			Factory: newResourceCollection,
 			Name:    "Collection",
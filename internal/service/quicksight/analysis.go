@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -76,7 +77,8 @@ func ResourceAnalysis() *schema.Resource {
 					Required: true,
 					ForceNew: true,
 				},
-				"definition": quicksightschema.AnalysisDefinitionSchema(),
+				"definition":      quicksightschema.AnalysisDefinitionSchema(),
+				"definition_json": quicksightschema.AnalysisDefinitionJSONSchema(),
 				"last_updated_time": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -136,7 +138,10 @@ func ResourceAnalysis() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			definitionJSONDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -170,6 +175,15 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("definition_json"); ok {
+		definition, err := quicksightschema.ExpandAnalysisDefinitionJSON(v.(string))
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAnalysis, d.Get("name").(string), err)
+		}
+
+		input.Definition = definition
+	}
+
 	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Parameters = quicksightschema.ExpandParameters(d.Get("parameters").([]interface{}))
 	}
@@ -238,6 +252,17 @@ func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("setting definition: %s", err)
 	}
 
+	if descResp.Definition != nil {
+		definitionJSON, err := quicksightschema.FlattenDefinitionJSON(descResp.Definition)
+		if err != nil {
+			return diag.Errorf("flattening definition_json: %s", err)
+		}
+
+		d.Set("definition_json", definitionJSON)
+	} else {
+		d.Set("definition_json", nil)
+	}
+
 	permsResp, err := conn.DescribeAnalysisPermissionsWithContext(ctx, &quicksight.DescribeAnalysisPermissionsInput{
 		AwsAccountId: aws.String(awsAccountId),
 		AnalysisId:   aws.String(analysisId),
@@ -272,6 +297,13 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		_, createdFromEntity := d.GetOk("source_entity")
 		if createdFromEntity {
 			in.SourceEntity = quicksightschema.ExpandAnalysisSourceEntity(d.Get("source_entity").([]interface{}))
+		} else if v := d.GetRawConfig().GetAttr("definition_json"); v.IsKnown() && !v.IsNull() {
+			definition, err := quicksightschema.ExpandAnalysisDefinitionJSON(d.Get("definition_json").(string))
+			if err != nil {
+				return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameAnalysis, d.Id(), err)
+			}
+
+			in.Definition = definition
 		} else {
 			in.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
 		}
@@ -386,6 +418,26 @@ func FindAnalysisByID(ctx context.Context, conn *quicksight.QuickSight, id strin
 	return out.Analysis, nil
 }
 
+// definitionJSONDiff keeps the typed and JSON representations of a definition
+// in step, marking whichever one isn't configured as recomputed on change.
+func definitionJSONDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.HasChange("definition_json") {
+		if err := diff.SetNewComputed("definition"); err != nil {
+			return err
+		}
+	} else if diff.HasChange("definition") {
+		if err := diff.SetNewComputed("definition_json"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func ParseAnalysisId(id string) (string, string, error) {
 	parts := strings.SplitN(id, ",", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
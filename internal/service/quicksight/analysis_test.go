@@ -225,6 +225,49 @@ func TestAccQuickSightAnalysis_forceDelete(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightAnalysis_definitionJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysis quicksight.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx, false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfig_definitionJSON(rId, rName, "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "analysis_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.sheets.0.title", "Test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_json"},
+			},
+			{
+				Config: testAccAnalysisConfig_definitionJSON(rId, rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.sheets.0.title", "Updated"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAnalysisDestroy(ctx context.Context, forceDelete bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
@@ -612,3 +655,36 @@ resource "aws_quicksight_analysis" "test" {
 }
 `, rId, rName))
 }
+
+func testAccAnalysisConfig_definitionJSON(rId, rName, sheetTitle string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id = %[1]q
+  name        = %[2]q
+
+  definition_json = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      DataSetArn = aws_quicksight_data_set.test.arn
+      Identifier = "1"
+    }]
+    Sheets = [{
+      SheetId = "Test1"
+      Title   = %[3]q
+      Visuals = [{
+        CustomContentVisual = {
+          DataSetIdentifier = "1"
+          VisualId          = "Test1"
+          Title = {
+            FormatText = {
+              PlainText = "Test"
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rId, rName, sheetTitle))
+}
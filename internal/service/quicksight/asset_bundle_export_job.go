@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_quicksight_asset_bundle_export_job", name="Asset Bundle Export Job")
+func ResourceAssetBundleExportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetBundleExportJobCreate,
+		ReadWithoutTimeout:   resourceAssetBundleExportJobRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_bundle_export_job_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"download_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.AssetBundleExportFormat_Values(), false),
+			},
+			"include_all_dependencies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameAssetBundleExportJob = "Asset Bundle Export Job"
+)
+
+func resourceAssetBundleExportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	jobID := d.Get("asset_bundle_export_job_id").(string)
+	id := createAssetBundleJobID(awsAccountID, jobID)
+
+	input := &quicksight.StartAssetBundleExportJobInput{
+		AssetBundleExportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+		ExportFormat:           aws.String(d.Get("export_format").(string)),
+		IncludeAllDependencies: aws.Bool(d.Get("include_all_dependencies").(bool)),
+		ResourceArns:           flex.ExpandStringSet(d.Get("resource_arns").(*schema.Set)),
+	}
+
+	_, err := conn.StartAssetBundleExportJobWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAssetBundleExportJob, jobID, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitAssetBundleExportJobSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionWaitingForCreation, ResNameAssetBundleExportJob, d.Id(), err)
+	}
+
+	return resourceAssetBundleExportJobRead(ctx, d, meta)
+}
+
+func resourceAssetBundleExportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	out, err := FindAssetBundleExportJobByID(ctx, conn, d.Id())
+
+	// QuickSight deletes job records about 14 days after the job completes. Starting a new job
+	// would repeat the export, so keep the last known state rather than removing the resource.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Asset Bundle Export Job (%s) record has expired, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionReading, ResNameAssetBundleExportJob, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("asset_bundle_export_job_id", out.AssetBundleExportJobId)
+	d.Set("aws_account_id", out.AwsAccountId)
+	if out.CreatedTime != nil {
+		d.Set("created_time", out.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("download_url", out.DownloadUrl)
+	d.Set("export_format", out.ExportFormat)
+	d.Set("include_all_dependencies", out.IncludeAllDependencies)
+	d.Set("job_status", out.JobStatus)
+	d.Set("resource_arns", aws.StringValueSlice(out.ResourceArns))
+
+	return nil
+}
+
+func FindAssetBundleExportJobByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	awsAccountID, jobID, err := ParseAssetBundleJobID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &quicksight.DescribeAssetBundleExportJobInput{
+		AssetBundleExportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	output, err := conn.DescribeAssetBundleExportJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetBundleExportJob(ctx context.Context, conn *quicksight.QuickSight, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssetBundleExportJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobStatus), nil
+	}
+}
+
+func waitAssetBundleExportJobSucceeded(ctx context.Context, conn *quicksight.QuickSight, id string, timeout time.Duration) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{quicksight.AssetBundleExportJobStatusQueuedForImmediateExecution, quicksight.AssetBundleExportJobStatusInProgress},
+		Target:  []string{quicksight.AssetBundleExportJobStatusSuccessful},
+		Refresh: statusAssetBundleExportJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DescribeAssetBundleExportJobOutput); ok {
+		var errs *multierror.Error
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("%s (%s): %s", aws.StringValue(v.Type), aws.StringValue(v.Arn), aws.StringValue(v.Message)))
+		}
+
+		tfresource.SetLastError(err, errs.ErrorOrNil())
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func ParseAssetBundleJobID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,JOB_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func createAssetBundleJobID(awsAccountID, jobID string) string {
+	return fmt.Sprintf("%s,%s", awsAccountID, jobID)
+}
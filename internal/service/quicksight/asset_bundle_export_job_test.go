@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightAssetBundleExportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var job quicksight.DescribeAssetBundleExportJobOutput
+	resourceName := "aws_quicksight_asset_bundle_export_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetBundleExportJobConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetBundleExportJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "asset_bundle_export_job_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "export_format", quicksight.AssetBundleExportFormatQuicksightJson),
+					resource.TestCheckResourceAttr(resourceName, "job_status", quicksight.AssetBundleExportJobStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "download_url"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"download_url"},
+			},
+		},
+	})
+}
+
+func testAccCheckAssetBundleExportJobExists(ctx context.Context, name string, job *quicksight.DescribeAssetBundleExportJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameAssetBundleExportJob, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameAssetBundleExportJob, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+		output, err := tfquicksight.FindAssetBundleExportJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameAssetBundleExportJob, rs.Primary.ID, err)
+		}
+
+		*job = *output
+
+		return nil
+	}
+}
+
+func testAccAssetBundleExportJobConfig_basic(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccThemeConfig_basic(rId, rName, "MIDNIGHT"),
+		fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_export_job" "test" {
+  asset_bundle_export_job_id = %[1]q
+  export_format              = "QUICKSIGHT_JSON"
+  resource_arns              = [aws_quicksight_theme.test.arn]
+}
+`, rId))
+}
@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_quicksight_asset_bundle_import_job", name="Asset Bundle Import Job")
+func ResourceAssetBundleImportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetBundleImportJobCreate,
+		ReadWithoutTimeout:   resourceAssetBundleImportJobRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAssetBundleImportJobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_bundle_import_job_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"asset_bundle_import_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsBase64,
+							ExactlyOneOf: []string{"asset_bundle_import_source.0.body", "asset_bundle_import_source.0.s3_uri"},
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"asset_bundle_import_source.0.body", "asset_bundle_import_source.0.s3_uri"},
+						},
+					},
+				},
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.AssetBundleImportFailureAction_Values(), false),
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"override_parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameAssetBundleImportJob = "Asset Bundle Import Job"
+)
+
+func resourceAssetBundleImportJobImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	out, err := FindAssetBundleImportJobByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Read doesn't refresh the import source or override parameters, as they are only known at creation time.
+	// Populate them here. For a source uploaded as a body the API returns a download URL instead of the contents.
+	// Leaving the source empty would replace the resource, and re-run the import, on the next apply.
+	v := out.AssetBundleImportSource
+	if v == nil || v.S3Uri == nil {
+		return nil, fmt.Errorf("QuickSight Asset Bundle Import Job (%s) was not imported from S3; only jobs with an s3_uri source can be imported", d.Id())
+	}
+
+	if err := d.Set("asset_bundle_import_source", []interface{}{map[string]interface{}{
+		"s3_uri": aws.StringValue(v.S3Uri),
+	}}); err != nil {
+		return nil, fmt.Errorf("setting asset_bundle_import_source: %w", err)
+	}
+
+	if v := out.OverrideParameters; v != nil {
+		b, err := jsonutil.BuildJSON(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("serializing override_parameters: %w", err)
+		}
+
+		overrideParameters, err := structure.NormalizeJsonString(string(b))
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.Set("override_parameters", overrideParameters)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAssetBundleImportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	jobID := d.Get("asset_bundle_import_job_id").(string)
+	id := createAssetBundleJobID(awsAccountID, jobID)
+
+	input := &quicksight.StartAssetBundleImportJobInput{
+		AssetBundleImportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	if v, ok := d.GetOk("asset_bundle_import_source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		source, err := expandAssetBundleImportSource(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAssetBundleImportJob, jobID, err)
+		}
+
+		input.AssetBundleImportSource = source
+	}
+
+	if v, ok := d.GetOk("failure_action"); ok {
+		input.FailureAction = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("override_parameters"); ok {
+		overrideParameters := &quicksight.AssetBundleImportJobOverrideParameters{}
+
+		if err := jsonutil.UnmarshalJSON(overrideParameters, strings.NewReader(v.(string))); err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAssetBundleImportJob, jobID, fmt.Errorf("parsing override_parameters: %w", err))
+		}
+
+		input.OverrideParameters = overrideParameters
+	}
+
+	_, err := conn.StartAssetBundleImportJobWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAssetBundleImportJob, jobID, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitAssetBundleImportJobSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionWaitingForCreation, ResNameAssetBundleImportJob, d.Id(), err)
+	}
+
+	return resourceAssetBundleImportJobRead(ctx, d, meta)
+}
+
+func resourceAssetBundleImportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	out, err := FindAssetBundleImportJobByID(ctx, conn, d.Id())
+
+	// QuickSight deletes job records about 14 days after the job completes. Starting a new job
+	// would repeat the import, so keep the last known state rather than removing the resource.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Asset Bundle Import Job (%s) record has expired, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionReading, ResNameAssetBundleImportJob, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("asset_bundle_import_job_id", out.AssetBundleImportJobId)
+	d.Set("aws_account_id", out.AwsAccountId)
+	if out.CreatedTime != nil {
+		d.Set("created_time", out.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("failure_action", out.FailureAction)
+	d.Set("job_status", out.JobStatus)
+
+	return nil
+}
+
+func expandAssetBundleImportSource(tfMap map[string]interface{}) (*quicksight.AssetBundleImportSource, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiObject := &quicksight.AssetBundleImportSource{}
+
+	if v, ok := tfMap["body"].(string); ok && v != "" {
+		body, err := base64.StdEncoding.DecodeString(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding asset_bundle_import_source body: %w", err)
+		}
+
+		apiObject.Body = body
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject, nil
+}
+
+func FindAssetBundleImportJobByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	awsAccountID, jobID, err := ParseAssetBundleJobID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &quicksight.DescribeAssetBundleImportJobInput{
+		AssetBundleImportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	output, err := conn.DescribeAssetBundleImportJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetBundleImportJob(ctx context.Context, conn *quicksight.QuickSight, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssetBundleImportJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobStatus), nil
+	}
+}
+
+func waitAssetBundleImportJobSucceeded(ctx context.Context, conn *quicksight.QuickSight, id string, timeout time.Duration) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			quicksight.AssetBundleImportJobStatusQueuedForImmediateExecution,
+			quicksight.AssetBundleImportJobStatusInProgress,
+			quicksight.AssetBundleImportJobStatusFailedRollbackInProgress,
+		},
+		Target:  []string{quicksight.AssetBundleImportJobStatusSuccessful},
+		Refresh: statusAssetBundleImportJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DescribeAssetBundleImportJobOutput); ok {
+		var errs *multierror.Error
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("%s (%s): %s", aws.StringValue(v.Type), aws.StringValue(v.Arn), aws.StringValue(v.Message)))
+		}
+
+		for _, v := range output.RollbackErrors {
+			errs = multierror.Append(errs, fmt.Errorf("rollback: %s (%s): %s", aws.StringValue(v.Type), aws.StringValue(v.Arn), aws.StringValue(v.Message)))
+		}
+
+		tfresource.SetLastError(err, errs.ErrorOrNil())
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	envVarAssetBundleS3URI = "QUICKSIGHT_ASSET_BUNDLE_S3_URI"
+)
+
+func TestAccQuickSightAssetBundleImportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	s3URI := envvar.SkipIfEmpty(t, envVarAssetBundleS3URI, "S3 URI of a QuickSight asset bundle exported in QUICKSIGHT_JSON format")
+
+	var job quicksight.DescribeAssetBundleImportJobOutput
+	resourceName := "aws_quicksight_asset_bundle_import_job.test"
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetBundleImportJobConfig_basic(rId, s3URI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetBundleImportJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "asset_bundle_import_job_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "failure_action", quicksight.AssetBundleImportFailureActionRollback),
+					resource.TestCheckResourceAttr(resourceName, "job_status", quicksight.AssetBundleImportJobStatusSuccessful),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssetBundleImportJobExists(ctx context.Context, name string, job *quicksight.DescribeAssetBundleImportJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameAssetBundleImportJob, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameAssetBundleImportJob, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+		output, err := tfquicksight.FindAssetBundleImportJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameAssetBundleImportJob, rs.Primary.ID, err)
+		}
+
+		*job = *output
+
+		return nil
+	}
+}
+
+func testAccAssetBundleImportJobConfig_basic(rId, s3URI string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_import_job" "test" {
+  asset_bundle_import_job_id = %[1]q
+  failure_action             = "ROLLBACK"
+
+  asset_bundle_import_source {
+    s3_uri = %[2]q
+  }
+
+  override_parameters = jsonencode({
+    ResourceIdOverrideConfiguration = {
+      PrefixForAllResources = "tf-acc-"
+    }
+  })
+}
+`, rId, s3URI)
+}
@@ -71,6 +71,7 @@ func ResourceDashboard() *schema.Resource {
 				},
 				"dashboard_publish_options": quicksightschema.DashboardPublishOptionsSchema(),
 				"definition":                quicksightschema.DashboardDefinitionSchema(),
+				"definition_json":           quicksightschema.DashboardDefinitionJSONSchema(),
 				"last_updated_time": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -136,6 +137,7 @@ func ResourceDashboard() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			refreshOutputsDiff,
+			definitionJSONDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -175,6 +177,15 @@ func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("definition_json"); ok {
+		definition, err := quicksightschema.ExpandDashboardDefinitionJSON(v.(string))
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameDashboard, d.Get("name").(string), err)
+		}
+
+		input.Definition = definition
+	}
+
 	if v, ok := d.GetOk("dashboard_publish_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.DashboardPublishOptions = quicksightschema.ExpandDashboardPublishOptions(d.Get("dashboard_publish_options").([]interface{}))
 	}
@@ -244,6 +255,17 @@ func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("setting definition: %s", err)
 	}
 
+	if descResp.Definition != nil {
+		definitionJSON, err := quicksightschema.FlattenDefinitionJSON(descResp.Definition)
+		if err != nil {
+			return diag.Errorf("flattening definition_json: %s", err)
+		}
+
+		d.Set("definition_json", definitionJSON)
+	} else {
+		d.Set("definition_json", nil)
+	}
+
 	if err := d.Set("dashboard_publish_options", quicksightschema.FlattenDashboardPublishOptions(descResp.DashboardPublishOptions)); err != nil {
 		return diag.Errorf("setting dashboard_publish_options: %s", err)
 	}
@@ -283,6 +305,13 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		_, createdFromEntity := d.GetOk("source_entity")
 		if createdFromEntity {
 			in.SourceEntity = quicksightschema.ExpandDashboardSourceEntity(d.Get("source_entity").([]interface{}))
+		} else if v := d.GetRawConfig().GetAttr("definition_json"); v.IsKnown() && !v.IsNull() {
+			definition, err := quicksightschema.ExpandDashboardDefinitionJSON(d.Get("definition_json").(string))
+			if err != nil {
+				return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameDashboard, d.Id(), err)
+			}
+
+			in.Definition = definition
 		} else {
 			in.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
 		}
@@ -420,7 +449,7 @@ func extractVersionFromARN(arn string) *int64 {
 }
 
 func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	if diff.HasChanges("name", "definition", "source_entity", "theme_arn", "version_description", "parameters", "dashboard_publish_options") {
+	if diff.HasChanges("name", "definition", "definition_json", "source_entity", "theme_arn", "version_description", "parameters", "dashboard_publish_options") {
 		if err := diff.SetNewComputed("version_number"); err != nil {
 			return err
 		}
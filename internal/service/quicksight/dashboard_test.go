@@ -197,6 +197,49 @@ func TestAccQuickSightDashboard_dashboardSpecificConfig(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDashboard_definitionJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var dashboard quicksight.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_definitionJSON(rId, rName, "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.sheets.0.title", "Test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_json"},
+			},
+			{
+				Config: testAccDashboardConfig_definitionJSON(rId, rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.sheets.0.title", "Updated"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDashboardDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
@@ -560,3 +603,37 @@ resource "aws_quicksight_dashboard" "test" {
 }
 `, rId, rName))
 }
+
+func testAccDashboardConfig_definitionJSON(rId, rName, sheetTitle string) string {
+	return acctest.ConfigCompose(
+		testAccDashboardConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = "version"
+
+  definition_json = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      DataSetArn = aws_quicksight_data_set.test.arn
+      Identifier = "1"
+    }]
+    Sheets = [{
+      SheetId = "Test1"
+      Title   = %[3]q
+      Visuals = [{
+        CustomContentVisual = {
+          DataSetIdentifier = "1"
+          VisualId          = "Test1"
+          Title = {
+            FormatText = {
+              PlainText = "Test"
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rId, rName, sheetTitle))
+}
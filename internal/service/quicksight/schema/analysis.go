@@ -19,6 +19,7 @@ func AnalysisDefinitionSchema() *schema.Schema {
 		Computed: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
@@ -129,6 +130,7 @@ func AnalysisSourceEntitySchema() *schema.Schema {
 		Optional: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
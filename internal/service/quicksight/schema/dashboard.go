@@ -19,6 +19,7 @@ func DashboardDefinitionSchema() *schema.Schema {
 		Computed: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
@@ -284,6 +285,7 @@ func DashboardSourceEntitySchema() *schema.Schema {
 		Optional: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
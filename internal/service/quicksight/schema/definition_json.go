@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// AnalysisDefinitionJSONSchema returns the schema for an analysis definition
+// supplied as the raw JSON document returned by DescribeAnalysisDefinition.
+func AnalysisDefinitionJSONSchema() *schema.Schema {
+	return definitionJSONSchema(func() interface{} { return &quicksight.AnalysisDefinition{} })
+}
+
+// DashboardDefinitionJSONSchema returns the schema for a dashboard definition
+// supplied as the raw JSON document returned by DescribeDashboardDefinition.
+func DashboardDefinitionJSONSchema() *schema.Schema {
+	return definitionJSONSchema(func() interface{} { return &quicksight.DashboardVersionDefinition{} })
+}
+
+func definitionJSONSchema(newDefinition func() interface{}) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ExactlyOneOf: []string{
+			"definition",
+			"definition_json",
+			"source_entity",
+		},
+		ValidateFunc: validation.All(
+			validation.StringIsJSON,
+			validateDefinitionJSON(newDefinition),
+		),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return definitionJSONEquivalent(old, new, newDefinition)
+		},
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+	}
+}
+
+func validateDefinitionJSON(newDefinition func() interface{}) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok || value == "" {
+			return
+		}
+
+		if _, err := expandDefinitionJSON(value, newDefinition()); err != nil {
+			errors = append(errors, fmt.Errorf("%q: %w", k, err))
+		}
+
+		return
+	}
+}
+
+// definitionJSONEquivalent compares two definition documents after round-tripping
+// each through the SDK shape, discarding ordering and whitespace.
+// Documents containing keys the SDK shape does not model are never equivalent.
+func definitionJSONEquivalent(old, new string, newDefinition func() interface{}) bool {
+	if verify.JSONStringsEqual(old, new) {
+		return true
+	}
+
+	if old == "" || new == "" {
+		return false
+	}
+
+	canonical1, err := canonicalDefinitionJSON(old, newDefinition())
+	if err != nil {
+		return false
+	}
+
+	canonical2, err := canonicalDefinitionJSON(new, newDefinition())
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(canonical1, canonical2)
+}
+
+func canonicalDefinitionJSON(s string, definition interface{}) ([]byte, error) {
+	definition, err := expandDefinitionJSON(s, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonutil.BuildJSON(definition)
+}
+
+func ExpandAnalysisDefinitionJSON(s string) (*quicksight.AnalysisDefinition, error) {
+	definition, err := expandDefinitionJSON(s, &quicksight.AnalysisDefinition{})
+	if err != nil {
+		return nil, err
+	}
+
+	return definition.(*quicksight.AnalysisDefinition), nil
+}
+
+func ExpandDashboardDefinitionJSON(s string) (*quicksight.DashboardVersionDefinition, error) {
+	definition, err := expandDefinitionJSON(s, &quicksight.DashboardVersionDefinition{})
+	if err != nil {
+		return nil, err
+	}
+
+	return definition.(*quicksight.DashboardVersionDefinition), nil
+}
+
+// expandDefinitionJSON unmarshals s into definition and returns an error naming
+// any keys that were dropped because the SDK shape does not model them.
+func expandDefinitionJSON(s string, definition interface{}) (interface{}, error) {
+	if err := jsonutil.UnmarshalJSON(definition, strings.NewReader(s)); err != nil {
+		return nil, err
+	}
+
+	b, err := jsonutil.BuildJSON(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	var input, output interface{}
+
+	if err := json.Unmarshal([]byte(s), &input); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &output); err != nil {
+		return nil, err
+	}
+
+	if keys := unsupportedDefinitionJSONKeys("", input, output); len(keys) > 0 {
+		sort.Strings(keys)
+		return nil, fmt.Errorf("definition contains keys not supported by this provider version: %s", strings.Join(keys, ", "))
+	}
+
+	return definition, nil
+}
+
+func unsupportedDefinitionJSONKeys(path string, input, output interface{}) []string {
+	var keys []string
+
+	switch input := input.(type) {
+	case map[string]interface{}:
+		output, _ := output.(map[string]interface{})
+
+		for k, v := range input {
+			if v == nil {
+				continue
+			}
+
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+
+			w, ok := output[k]
+			if !ok {
+				keys = append(keys, p)
+				continue
+			}
+
+			keys = append(keys, unsupportedDefinitionJSONKeys(p, v, w)...)
+		}
+	case []interface{}:
+		output, _ := output.([]interface{})
+
+		for i, v := range input {
+			var w interface{}
+			if i < len(output) {
+				w = output[i]
+			}
+
+			keys = append(keys, unsupportedDefinitionJSONKeys(fmt.Sprintf("%s[%d]", path, i), v, w)...)
+		}
+	}
+
+	return keys
+}
+
+func FlattenDefinitionJSON(definition interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(definition)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
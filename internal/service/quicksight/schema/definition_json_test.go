@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"strings"
+	"testing"
+
+	quicksightschema "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight/schema"
+)
+
+func TestExpandAnalysisDefinitionJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       string
+		expectedErr string
+	}{
+		{
+			name: "supported",
+			input: `{
+  "DataSetIdentifierDeclarations": [{"DataSetArn": "arn", "Identifier": "1"}],
+  "Sheets": [{"SheetId": "1", "Visuals": [{"BarChartVisual": {"VisualId": "BarChart", "Subtitle": null}}]}]
+}`,
+		},
+		{
+			name: "unknown visual type",
+			input: `{
+  "DataSetIdentifierDeclarations": [{"DataSetArn": "arn", "Identifier": "1"}],
+  "Sheets": [{"SheetId": "1", "Visuals": [{"BarChartVisual": {"VisualId": "BarChart"}}, {"LayerMapVisual": {"VisualId": "LayerMap"}}]}]
+}`,
+			expectedErr: "Sheets[0].Visuals[1].LayerMapVisual",
+		},
+		{
+			name:        "unknown top-level key",
+			input:       `{"DataSetIdentifierDeclarations": [], "StaticFiles": []}`,
+			expectedErr: "StaticFiles",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := quicksightschema.ExpandAnalysisDefinitionJSON(testCase.input)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.expectedErr)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Errorf("expected error containing %q, got %q", testCase.expectedErr, err)
+			}
+		})
+	}
+}
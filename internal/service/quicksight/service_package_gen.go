@@ -98,6 +98,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceAssetBundleExportJob,
+			TypeName: "aws_quicksight_asset_bundle_export_job",
+			Name:     "Asset Bundle Export Job",
+		},
+		{
+			Factory:  ResourceAssetBundleImportJob,
+			TypeName: "aws_quicksight_asset_bundle_import_job",
+			Name:     "Asset Bundle Import Job",
+		},
 		{
 			Factory:  ResourceDashboard,
 			TypeName: "aws_quicksight_dashboard",
@@ -213,3 +213,27 @@ func FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx context.Contex
 
 	return output.ResourceShareAssociations[0], nil
 }
+
+func FindResourceShareInvitations(ctx context.Context, conn *ram.RAM, input *ram.GetResourceShareInvitationsInput) ([]*ram.ResourceShareInvitation, error) {
+	var output []*ram.ResourceShareInvitation
+
+	err := conn.GetResourceShareInvitationsPagesWithContext(ctx, input, func(page *ram.GetResourceShareInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceShareInvitations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -27,12 +27,17 @@ func ResourcePrincipalAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePrincipalAssociationCreate,
 		ReadWithoutTimeout:   resourcePrincipalAssociationRead,
+		UpdateWithoutTimeout: resourcePrincipalAssociationUpdate,
 		DeleteWithoutTimeout: resourcePrincipalAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(PrincipalAssociationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_share_arn": {
 				Type:         schema.TypeString,
@@ -50,6 +55,12 @@ func ResourcePrincipalAssociation() *schema.Resource {
 					verify.ValidARN,
 				),
 			},
+
+			"wait_for_association": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -76,11 +87,11 @@ func resourcePrincipalAssociationCreate(ctx context.Context, d *schema.ResourceD
 	d.SetId(fmt.Sprintf("%s,%s", resourceShareArn, principal))
 
 	// AWS Account ID Principals need to be accepted to become ASSOCIATED
-	if ok, _ := regexp.MatchString(`^\d{12}$`, principal); ok {
+	if ok, _ := regexp.MatchString(`^\d{12}$`, principal); ok && !d.Get("wait_for_association").(bool) {
 		return append(diags, resourcePrincipalAssociationRead(ctx, d, meta)...)
 	}
 
-	if _, err := WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareArn, principal); err != nil {
+	if _, err := WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareArn, principal, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM principal association (%s) to become ready: %s", d.Id(), err)
 	}
 
@@ -102,7 +113,7 @@ func resourcePrincipalAssociationRead(ctx context.Context, d *schema.ResourceDat
 		// AWS Account ID Principals need to be accepted to become ASSOCIATED
 		association, err = FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx, conn, resourceShareArn, principal)
 	} else {
-		association, err = WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareArn, principal)
+		association, err = WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareArn, principal, PrincipalAssociationTimeout)
 	}
 
 	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceArnNotFoundException) || tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException)) {
@@ -131,6 +142,14 @@ func resourcePrincipalAssociationRead(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func resourcePrincipalAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// "wait_for_association" only affects creation.
+
+	return append(diags, resourcePrincipalAssociationRead(ctx, d, meta)...)
+}
+
 func resourcePrincipalAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RAMConn(ctx)
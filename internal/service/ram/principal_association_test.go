@@ -38,9 +38,35 @@ func TestAccRAMPrincipalAssociation_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_association"},
+			},
+		},
+	})
+}
+
+func TestAccRAMPrincipalAssociation_waitForAssociation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ram_principal_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckResourceShareAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalAssociationConfig_waitForAssociation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_association", "true"),
+					resource.TestCheckResourceAttr("aws_ram_resource_share_accepter.test", "status", ram.ResourceShareStatusActive),
+				),
 			},
 		},
 	})
@@ -95,7 +121,7 @@ func testAccCheckPrincipalAssociationExists(ctx context.Context, resourceName st
 			// AWS Account ID Principals need to be accepted to become ASSOCIATED
 			association, err = tfram.FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx, conn, resourceShareARN, principal)
 		} else {
-			association, err = tfram.WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareARN, principal)
+			association, err = tfram.WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareARN, principal, tfram.PrincipalAssociationTimeout)
 		}
 
 		if err != nil {
@@ -159,3 +185,30 @@ resource "aws_ram_principal_association" "test" {
 }
 `, rName)
 }
+
+func testAccPrincipalAssociationConfig_waitForAssociation(rName string) string {
+	return acctest.ConfigAlternateAccountProvider() + fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal            = data.aws_caller_identity.receiver.account_id
+  resource_share_arn   = aws_ram_resource_share.test.arn
+  wait_for_association = true
+}
+
+# The accepter must not depend on the waiting association, or the apply deadlocks.
+# It waits for the invitation that the association sends.
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_caller_identity" "receiver" {}
+`, rName)
+}
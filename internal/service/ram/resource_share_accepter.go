@@ -14,10 +14,12 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -92,18 +94,36 @@ func resourceResourceShareAccepterCreate(ctx context.Context, d *schema.Resource
 
 	shareARN := d.Get("share_arn").(string)
 
-	invitation, err := FindResourceShareInvitationByResourceShareARNAndStatus(ctx, conn, shareARN, ram.ResourceShareInvitationStatusPending)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutCreate))
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating RAM Resource Share Accepter: %s", err)
-	}
+	// The invitation is sent when the principal is associated with the resource share, which may happen concurrently
+	// (e.g. an aws_ram_principal_association with wait_for_association set). Wait for it.
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, deadline.Remaining(), func() (interface{}, error) {
+		invitation, err := resourceShareInvitationByResourceShareARNAndStatus(ctx, conn, shareARN, ram.ResourceShareInvitationStatusPending)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if invitation == nil || aws.StringValue(invitation.ResourceShareInvitationArn) == "" {
+			return nil, &retry.NotFoundError{}
+		}
 
-	if invitation == nil || aws.StringValue(invitation.ResourceShareInvitationArn) == "" {
+		return invitation, nil
+	})
+
+	if tfresource.NotFound(err) {
 		return sdkdiag.AppendErrorf(diags, "No RAM Resource Share (%s) invitation found\n\n"+
 			"NOTE: If both AWS accounts are in the same AWS Organization and RAM Sharing with AWS Organizations is enabled, this resource is not necessary",
 			shareARN)
 	}
 
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Resource Share Accepter: %s", err)
+	}
+
+	invitation := outputRaw.(*ram.ResourceShareInvitation)
+
 	input := &ram.AcceptResourceShareInvitationInput{
 		ClientToken:                aws.String(id.UniqueId()),
 		ResourceShareInvitationArn: invitation.ResourceShareInvitationArn,
@@ -120,7 +140,7 @@ func resourceResourceShareAccepterCreate(ctx context.Context, d *schema.Resource
 
 	_, err = WaitResourceShareInvitationAccepted(ctx, conn,
 		aws.StringValue(output.ResourceShareInvitation.ResourceShareInvitationArn),
-		d.Timeout(schema.TimeoutCreate),
+		deadline.Remaining(),
 	)
 
 	if err != nil {
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_ram_resource_share_invitations", name="Resource Share Invitations")
+func DataSourceResourceShareInvitations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourceShareInvitationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"invitations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invitation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"receiver_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"receiver_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_share_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_share_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sender_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_share_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ram.ResourceShareInvitationStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceResourceShareInvitationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	input := &ram.GetResourceShareInvitationsInput{}
+
+	if v, ok := d.GetOk("resource_share_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceShareArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	invitations, err := FindResourceShareInvitations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share Invitations: %s", err)
+	}
+
+	status := d.Get("status").(string)
+	var tfList []interface{}
+
+	for _, v := range invitations {
+		if status != "" && aws.StringValue(v.Status) != status {
+			continue
+		}
+
+		tfList = append(tfList, flattenResourceShareInvitation(v))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("invitations", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting invitations: %s", err)
+	}
+
+	return diags
+}
+
+func flattenResourceShareInvitation(apiObject *ram.ResourceShareInvitation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                 aws.StringValue(apiObject.ResourceShareInvitationArn),
+		"receiver_account_id": aws.StringValue(apiObject.ReceiverAccountId),
+		"receiver_arn":        aws.StringValue(apiObject.ReceiverArn),
+		"resource_share_arn":  aws.StringValue(apiObject.ResourceShareArn),
+		"resource_share_name": aws.StringValue(apiObject.ResourceShareName),
+		"sender_account_id":   aws.StringValue(apiObject.SenderAccountId),
+		"status":              aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.InvitationTimestamp; v != nil {
+		tfMap["invitation_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRAMResourceShareInvitationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ram_resource_share_invitations.test"
+	resourceName := "aws_ram_resource_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareInvitationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.invitation_timestamp"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "invitations.0.receiver_account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invitations.0.resource_share_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.0.resource_share_name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.sender_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "invitations.0.status", ram.ResourceShareInvitationStatusPending),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceShareInvitationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigAlternateAccountProvider() + fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.receiver.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_caller_identity" "receiver" {}
+
+data "aws_ram_resource_share_invitations" "test" {
+  resource_share_arns = [aws_ram_principal_association.test.resource_share_arn]
+  status              = "PENDING"
+}
+`, rName)
+}
@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceResourceShare,
 			TypeName: "aws_ram_resource_share",
 		},
+		{
+			Factory:  DataSourceResourceShareInvitations,
+			TypeName: "aws_ram_resource_share_invitations",
+			Name:     "Resource Share Invitations",
+		},
 	}
 }
 
@@ -88,12 +88,14 @@ func WaitResourceShareOwnedBySelfDeleted(ctx context.Context, conn *ram.RAM, arn
 	return nil, err
 }
 
-func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string) (*ram.ResourceShareAssociation, error) {
+// WaitResourceSharePrincipalAssociated waits for a principal association to return ASSOCIATED.
+// Associations with AWS account principals outside the organization only become ASSOCIATED once the invitation is accepted.
+func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string, timeout time.Duration) (*ram.ResourceShareAssociation, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{ram.ResourceShareAssociationStatusAssociating, PrincipalAssociationStatusNotFound},
 		Target:  []string{ram.ResourceShareAssociationStatusAssociated},
 		Refresh: StatusResourceSharePrincipalAssociation(ctx, conn, resourceShareARN, principal),
-		Timeout: PrincipalAssociationTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -0,0 +1,547 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	appVersionDraft   = "draft"
+	appVersionRelease = "release"
+)
+
+// @SDKResource("aws_resiliencehub_app", name="App")
+// @Tags(identifierAttribute="arn")
+func ResourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      resiliencehub.AppAssessmentScheduleTypeDisabled,
+				ValidateFunc: validation.StringInSlice(resiliencehub.AppAssessmentScheduleType_Values(), false),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"resiliency_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"source_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"terraform_source_s3_state_file_urls": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &resiliencehub.CreateAppInput{
+		AssessmentSchedule: aws.String(d.Get("assessment_schedule").(string)),
+		ClientToken:        aws.String(id.UniqueId()),
+		Name:               aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAppWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.App.AppArn))
+
+	if hasAppSources(d) {
+		if err := importAppResources(ctx, conn, d.Id(), d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	app, err := FindAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", app.AppArn)
+	d.Set("assessment_schedule", app.AssessmentSchedule)
+	d.Set("compliance_status", app.ComplianceStatus)
+	d.Set("description", app.Description)
+	d.Set("name", app.Name)
+	d.Set("resiliency_policy_arn", app.PolicyArn)
+	d.Set("resiliency_score", app.ResiliencyScore)
+	d.Set("status", app.Status)
+
+	sources, err := findAppInputSources(ctx, conn, d.Id(), appVersionDraft)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s) input sources: %s", d.Id(), err)
+	}
+
+	var sourceARNs, stateFileURLs []string
+
+	for _, v := range sources {
+		if v.TerraformSource != nil {
+			stateFileURLs = append(stateFileURLs, aws.StringValue(v.TerraformSource.S3StateFileUrl))
+		} else if v.SourceArn != nil {
+			sourceARNs = append(sourceARNs, aws.StringValue(v.SourceArn))
+		}
+	}
+
+	d.Set("source_arns", sourceARNs)
+	d.Set("terraform_source_s3_state_file_urls", stateFileURLs)
+
+	version, err := findLatestAppVersion(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("version", version)
+
+	return diags
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	if d.HasChanges("assessment_schedule", "description", "resiliency_policy_arn") {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("assessment_schedule") {
+			input.AssessmentSchedule = aws.String(d.Get("assessment_schedule").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("resiliency_policy_arn") {
+			if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+				input.PolicyArn = aws.String(v.(string))
+			} else {
+				input.ClearResiliencyPolicyArn = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.UpdateAppWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("source_arns", "terraform_source_s3_state_file_urls") {
+		if hasAppSources(d) {
+			if err := importAppResources(ctx, conn, d.Id(), d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := deleteAppInputSources(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Resilience Hub App: %s", d.Id())
+	_, err := conn.DeleteAppWithContext(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(d.Id()),
+		ClientToken: aws.String(id.UniqueId()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Resilience Hub App (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func hasAppSources(d *schema.ResourceData) bool {
+	return d.Get("source_arns").(*schema.Set).Len() > 0 || d.Get("terraform_source_s3_state_file_urls").(*schema.Set).Len() > 0
+}
+
+// importAppResources replaces the resources of the application's draft version with those from the configured sources,
+// waits for the import to complete and then publishes the draft as a new application version.
+func importAppResources(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, d *schema.ResourceData, timeout time.Duration) error {
+	input := &resiliencehub.ImportResourcesToDraftAppVersionInput{
+		AppArn:         aws.String(arn),
+		ImportStrategy: aws.String(resiliencehub.ResourceImportStrategyTypeReplaceAll),
+	}
+
+	if v := d.Get("source_arns").(*schema.Set); v.Len() > 0 {
+		input.SourceArns = flex.ExpandStringSet(v)
+	}
+
+	for _, v := range d.Get("terraform_source_s3_state_file_urls").(*schema.Set).List() {
+		input.TerraformSources = append(input.TerraformSources, &resiliencehub.TerraformSource{
+			S3StateFileUrl: aws.String(v.(string)),
+		})
+	}
+
+	_, err := conn.ImportResourcesToDraftAppVersionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("importing resources: %w", err)
+	}
+
+	if _, err := waitAppResourcesImported(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for resource import: %w", err)
+	}
+
+	_, err = conn.PublishAppVersionWithContext(ctx, &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("publishing version: %w", err)
+	}
+
+	return nil
+}
+
+func deleteAppInputSources(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) error {
+	sources, err := findAppInputSources(ctx, conn, arn, appVersionDraft)
+
+	if err != nil {
+		return fmt.Errorf("reading input sources: %w", err)
+	}
+
+	for _, v := range sources {
+		input := &resiliencehub.DeleteAppInputSourceInput{
+			AppArn:          aws.String(arn),
+			ClientToken:     aws.String(id.UniqueId()),
+			SourceArn:       v.SourceArn,
+			TerraformSource: v.TerraformSource,
+		}
+
+		_, err := conn.DeleteAppInputSourceWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting input source: %w", err)
+		}
+	}
+
+	_, err = conn.PublishAppVersionWithContext(ctx, &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("publishing version: %w", err)
+	}
+
+	return nil
+}
+
+func FindAppByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.App, error) {
+	input := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAppWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.App, nil
+}
+
+func findAppInputSources(ctx context.Context, conn *resiliencehub.ResilienceHub, arn, version string) ([]*resiliencehub.AppInputSource, error) {
+	input := &resiliencehub.ListAppInputSourcesInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(version),
+	}
+	var output []*resiliencehub.AppInputSource
+
+	err := conn.ListAppInputSourcesPagesWithContext(ctx, input, func(page *resiliencehub.ListAppInputSourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppInputSources {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findLatestAppVersion returns the most recently published version of the specified application,
+// or an empty string if no version has been published.
+func findLatestAppVersion(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (string, error) {
+	input := &resiliencehub.ListAppVersionsInput{
+		AppArn: aws.String(arn),
+	}
+	var output string
+
+	err := conn.ListAppVersionsPagesWithContext(ctx, input, func(page *resiliencehub.ListAppVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppVersions {
+			if v := aws.StringValue(v.AppVersion); v != appVersionDraft && v != appVersionRelease {
+				output = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return output, nil
+}
+
+func findDraftAppVersionResourcesImportStatus(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	input := &resiliencehub.DescribeDraftAppVersionResourcesImportStatusInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDraftAppVersionResourcesImportStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusApp(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusAppResourcesImport(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDraftAppVersionResourcesImportStatus(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{resiliencehub.AppStatusTypeActive, resiliencehub.AppStatusTypeDeleting},
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppResourcesImported(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{resiliencehub.ResourceImportStatusTypePending, resiliencehub.ResourceImportStatusTypeInProgress},
+		Target:  []string{resiliencehub.ResourceImportStatusTypeSuccess},
+		Refresh: statusAppResourcesImport(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput); ok {
+		if aws.StringValue(output.Status) == resiliencehub.ResourceImportStatusTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_resiliencehub_app_assessment", name="App Assessment")
+// @Tags(identifierAttribute="arn")
+func ResourceAppAssessment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppAssessmentCreate,
+		ReadWithoutTimeout:   resourceAppAssessmentRead,
+		UpdateWithoutTimeout: resourceAppAssessmentUpdate,
+		DeleteWithoutTimeout: resourceAppAssessmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"app_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"app_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  appVersionRelease,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAppAssessmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &resiliencehub.StartAppAssessmentInput{
+		AppArn:         aws.String(d.Get("app_arn").(string)),
+		AppVersion:     aws.String(d.Get("app_version").(string)),
+		AssessmentName: aws.String(name),
+		ClientToken:    aws.String(id.UniqueId()),
+		Tags:           getTagsIn(ctx),
+	}
+
+	output, err := conn.StartAppAssessmentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Resilience Hub App Assessment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assessment.AssessmentArn))
+
+	if _, err := waitAppAssessmentSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Resilience Hub App Assessment (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAppAssessmentRead(ctx, d, meta)...)
+}
+
+func resourceAppAssessmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	assessment, err := FindAppAssessmentByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App Assessment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App Assessment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_arn", assessment.AppArn)
+	d.Set("app_version", assessment.AppVersion)
+	d.Set("arn", assessment.AssessmentArn)
+	d.Set("compliance_status", assessment.ComplianceStatus)
+	d.Set("name", assessment.AssessmentName)
+	if v := assessment.ResiliencyScore; v != nil {
+		d.Set("resiliency_score", v.Score)
+	} else {
+		d.Set("resiliency_score", nil)
+	}
+	d.Set("status", assessment.AssessmentStatus)
+
+	return diags
+}
+
+func resourceAppAssessmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAppAssessmentRead(ctx, d, meta)
+}
+
+func resourceAppAssessmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Resilience Hub App Assessment: %s", d.Id())
+	_, err := conn.DeleteAppAssessmentWithContext(ctx, &resiliencehub.DeleteAppAssessmentInput{
+		AssessmentArn: aws.String(d.Id()),
+		ClientToken:   aws.String(id.UniqueId()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App Assessment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAppAssessmentByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.AppAssessment, error) {
+	input := &resiliencehub.DescribeAppAssessmentInput{
+		AssessmentArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAppAssessmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+func statusAppAssessment(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAppAssessmentByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssessmentStatus), nil
+	}
+}
+
+func waitAppAssessmentSucceeded(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.AppAssessment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{resiliencehub.AssessmentStatusPending, resiliencehub.AssessmentStatusInProgress},
+		Target:  []string{resiliencehub.AssessmentStatusSuccess},
+		Refresh: statusAppAssessment(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.AppAssessment); ok {
+		if aws.StringValue(output.AssessmentStatus) == resiliencehub.AssessmentStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
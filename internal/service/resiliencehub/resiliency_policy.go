@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_resiliencehub_resiliency_policy", name="Resiliency Policy")
+// @Tags(identifierAttribute="arn")
+func ResourceResiliencyPolicy() *schema.Resource {
+	failurePolicySchema := func(required bool) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: required,
+			Optional: !required,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rpo_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"rto_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResiliencyPolicyCreate,
+		ReadWithoutTimeout:   resourceResiliencyPolicyRead,
+		UpdateWithoutTimeout: resourceResiliencyPolicyUpdate,
+		DeleteWithoutTimeout: resourceResiliencyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_location_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.DataLocationConstraint_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"estimated_cost_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validResourceName,
+			},
+			"policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az":       failurePolicySchema(true),
+						"hardware": failurePolicySchema(true),
+						"region":   failurePolicySchema(false),
+						"software": failurePolicySchema(true),
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.ResiliencyPolicyTier_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceResiliencyPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &resiliencehub.CreateResiliencyPolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Policy:      expandFailurePolicies(d.Get("policy").([]interface{})),
+		PolicyName:  aws.String(name),
+		Tags:        getTagsIn(ctx),
+		Tier:        aws.String(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("data_location_constraint"); ok {
+		input.DataLocationConstraint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.PolicyDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateResiliencyPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resilience Hub Resiliency Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyArn))
+
+	return append(diags, resourceResiliencyPolicyRead(ctx, d, meta)...)
+}
+
+func resourceResiliencyPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	policy, err := FindResiliencyPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub Resiliency Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", policy.PolicyArn)
+	d.Set("data_location_constraint", policy.DataLocationConstraint)
+	d.Set("description", policy.PolicyDescription)
+	d.Set("estimated_cost_tier", policy.EstimatedCostTier)
+	d.Set("name", policy.PolicyName)
+	if err := d.Set("policy", flattenFailurePolicies(policy.Policy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policy: %s", err)
+	}
+	d.Set("tier", policy.Tier)
+
+	return diags
+}
+
+func resourceResiliencyPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &resiliencehub.UpdateResiliencyPolicyInput{
+			PolicyArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_location_constraint") {
+			input.DataLocationConstraint = aws.String(d.Get("data_location_constraint").(string))
+		}
+
+		if d.HasChange("description") {
+			input.PolicyDescription = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.PolicyName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("policy") {
+			input.Policy = expandFailurePolicies(d.Get("policy").([]interface{}))
+		}
+
+		if d.HasChange("tier") {
+			input.Tier = aws.String(d.Get("tier").(string))
+		}
+
+		_, err := conn.UpdateResiliencyPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResiliencyPolicyRead(ctx, d, meta)...)
+}
+
+func resourceResiliencyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Resilience Hub Resiliency Policy: %s", d.Id())
+	_, err := conn.DeleteResiliencyPolicyWithContext(ctx, &resiliencehub.DeleteResiliencyPolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		PolicyArn:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindResiliencyPolicyByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.ResiliencyPolicy, error) {
+	input := &resiliencehub.DescribeResiliencyPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeResiliencyPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
+
+// failurePolicyDisruptionTypes maps policy block names to Resilience Hub disruption types.
+var failurePolicyDisruptionTypes = map[string]string{
+	"az":       resiliencehub.DisruptionTypeAz,
+	"hardware": resiliencehub.DisruptionTypeHardware,
+	"region":   resiliencehub.DisruptionTypeRegion,
+	"software": resiliencehub.DisruptionTypeSoftware,
+}
+
+func expandFailurePolicies(tfList []interface{}) map[string]*resiliencehub.FailurePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := make(map[string]*resiliencehub.FailurePolicy)
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		v, ok := tfMap[k].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		tfMap := v[0].(map[string]interface{})
+		apiObject[disruptionType] = &resiliencehub.FailurePolicy{
+			RpoInSecs: aws.Int64(int64(tfMap["rpo_in_secs"].(int))),
+			RtoInSecs: aws.Int64(int64(tfMap["rto_in_secs"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFailurePolicies(apiObject map[string]*resiliencehub.FailurePolicy) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		v, ok := apiObject[disruptionType]
+
+		if !ok || v == nil {
+			continue
+		}
+
+		tfMap[k] = []interface{}{
+			map[string]interface{}{
+				"rpo_in_secs": aws.Int64Value(v.RpoInSecs),
+				"rto_in_secs": aws.Int64Value(v.RtoInSecs),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
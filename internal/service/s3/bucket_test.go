@@ -1064,6 +1064,64 @@ func TestAccS3Bucket_Manage_versioning(t *testing.T) {
 	})
 }
 
+// TestAccS3Bucket_Manage_versioningRefreshDetailMinimal: with minimal refresh detail, versioning
+// suspended out of band is not noticed on refresh but is still read on import.
+func TestAccS3Bucket_Manage_versioningRefreshDetailMinimal(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigRefreshDetail(conns.RefreshDetailMinimal),
+					testAccBucketConfig_versioning(bucketName, true),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versioning.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigRefreshDetail(conns.RefreshDetailMinimal),
+					testAccBucketConfig_versioning(bucketName, true),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(ctx, resourceName),
+					testAccCheckBucketSuspendVersioning(ctx, resourceName),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versioning.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+				ExpectError:             regexp.MustCompile(`versioning\.0\.enabled`),
+			},
+		},
+	})
+}
+
 func TestAccS3Bucket_Manage_versioningDisabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2615,6 +2673,26 @@ func testAccCheckBucketAddObjects(ctx context.Context, n string, keys ...string)
 	}
 }
 
+func testAccCheckBucketSuspendVersioning(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		_, err := conn.PutBucketVersioningWithContext(ctx, &s3.PutBucketVersioningInput{
+			Bucket: aws.String(rs.Primary.ID),
+			VersioningConfiguration: &s3.VersioningConfiguration{
+				Status: aws.String(s3.BucketVersioningStatusSuspended),
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("PutBucketVersioning error: %s", err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBucketAddObjectsWithLegalHold(ctx context.Context, n string, keys ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
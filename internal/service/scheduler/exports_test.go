@@ -7,4 +7,5 @@ package scheduler
 var (
 	FindScheduleByTwoPartKey = findScheduleByTwoPartKey
 	ResourceSchedule         = resourceSchedule
+	ValidateTargetParameters = validateTargetParameters
 )
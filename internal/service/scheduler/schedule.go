@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -44,6 +45,8 @@ func resourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -168,10 +171,11 @@ func resourceSchedule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"capacity_provider_strategy": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										MaxItems: 6,
-										Set:      capacityProviderHash,
+										Type:          schema.TypeSet,
+										Optional:      true,
+										MaxItems:      6,
+										Set:           capacityProviderHash,
+										ConflictsWith: []string{"target.0.ecs_parameters.0.launch_type"},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"base": {
@@ -209,6 +213,7 @@ func resourceSchedule() *schema.Resource {
 										Type:             schema.TypeString,
 										Optional:         true,
 										ValidateDiagFunc: enum.Validate[types.LaunchType](),
+										ConflictsWith:    []string{"target.0.ecs_parameters.0.capacity_provider_strategy"},
 									},
 									"network_configuration": {
 										Type:     schema.TypeList,
@@ -643,6 +648,68 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceScheduleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("target.0.arn") {
+		return nil
+	}
+
+	var parameters []string
+
+	for _, tp := range targetParameters {
+		if v, ok := d.GetOk("target.0." + tp.name); ok && len(v.([]interface{})) > 0 {
+			parameters = append(parameters, tp.name)
+		}
+	}
+
+	return validateTargetParameters(d.Get("target.0.arn").(string), parameters)
+}
+
+// targetParameters lists the target parameter blocks and the templated targets that accept them.
+var targetParameters = []struct {
+	name           string
+	service        string
+	resourcePrefix string
+}{
+	{"ecs_parameters", "ecs", "cluster/"},
+	{"eventbridge_parameters", "events", "event-bus/"},
+	{"kinesis_parameters", "kinesis", "stream/"},
+	{"sagemaker_pipeline_parameters", "sagemaker", "pipeline/"},
+	{"sqs_parameters", "sqs", ""},
+}
+
+// validateTargetParameters returns an error if any of the named target parameter blocks
+// cannot be used with the target ARN. Universal targets accept only input.
+func validateTargetParameters(targetARN string, parameters []string) error {
+	v, err := arn.Parse(targetARN)
+
+	if err != nil {
+		// The ARN itself is validated by the schema.
+		return nil
+	}
+
+	if v.Service == "scheduler" && strings.HasPrefix(v.Resource, "aws-sdk:") {
+		if len(parameters) > 0 {
+			return fmt.Errorf("target.0.%s cannot be used with universal target %s, use input instead", parameters[0], targetARN)
+		}
+
+		return nil
+	}
+
+	for _, name := range parameters {
+		for _, tp := range targetParameters {
+			if tp.name != name {
+				continue
+			}
+
+			if v.Service != tp.service || !strings.HasPrefix(v.Resource, tp.resourcePrefix) {
+				return fmt.Errorf("target.0.%s cannot be used with target %s", name, targetARN)
+			}
+		}
+	}
+
+	return nil
+}
+
 func findScheduleByTwoPartKey(ctx context.Context, conn *scheduler.Client, groupName, scheduleName string) (*scheduler.GetScheduleOutput, error) {
 	in := &scheduler.GetScheduleInput{
 		GroupName: aws.String(groupName),
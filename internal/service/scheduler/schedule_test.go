@@ -177,6 +177,85 @@ func TestResourceScheduleParseID(t *testing.T) {
 	}
 }
 
+func TestValidateTargetParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name       string
+		ARN        string
+		Parameters []string
+		Fails      bool
+	}{
+		{
+			Name:       "ECS cluster",
+			ARN:        "arn:aws:ecs:us-east-1:123456789012:cluster/test", //lintignore:AWSAT003,AWSAT005
+			Parameters: []string{"ecs_parameters"},
+		},
+		{
+			Name:       "EventBridge event bus",
+			ARN:        "arn:aws:events:us-east-1:123456789012:event-bus/test", //lintignore:AWSAT003,AWSAT005
+			Parameters: []string{"eventbridge_parameters"},
+		},
+		{
+			Name:       "SageMaker pipeline",
+			ARN:        "arn:aws:sagemaker:us-east-1:123456789012:pipeline/test", //lintignore:AWSAT003,AWSAT005
+			Parameters: []string{"sagemaker_pipeline_parameters"},
+		},
+		{
+			Name:       "SQS queue",
+			ARN:        "arn:aws:sqs:us-east-1:123456789012:test.fifo", //lintignore:AWSAT003,AWSAT005
+			Parameters: []string{"sqs_parameters"},
+		},
+		{
+			Name: "universal target without parameters",
+			ARN:  "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+		},
+		{
+			Name:       "universal target with parameters",
+			ARN:        "arn:aws:scheduler:::aws-sdk:ecs:runTask", //lintignore:AWSAT005
+			Parameters: []string{"ecs_parameters"},
+			Fails:      true,
+		},
+		{
+			Name:       "ECS parameters with SQS queue",
+			ARN:        "arn:aws:sqs:us-east-1:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			Parameters: []string{"ecs_parameters"},
+			Fails:      true,
+		},
+		{
+			Name:       "EventBridge parameters with rule",
+			ARN:        "arn:aws:events:us-east-1:123456789012:rule/test", //lintignore:AWSAT003,AWSAT005
+			Parameters: []string{"eventbridge_parameters"},
+			Fails:      true,
+		},
+		{
+			Name:       "SageMaker pipeline parameters with notebook instance",
+			ARN:        "arn:aws:sagemaker:us-east-1:123456789012:notebook-instance/test", //lintignore:AWSAT003,AWSAT005
+			Parameters: []string{"sagemaker_pipeline_parameters"},
+			Fails:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfscheduler.ValidateTargetParameters(tc.ARN, tc.Parameters)
+
+			if tc.Fails {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("expected no error, got: %s", err)
+				}
+			}
+		})
+	}
+}
+
 func TestAccSchedulerSchedule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -39,6 +39,12 @@ func ResourceDiscoverer() *schema.Resource {
 				Computed: true,
 			},
 
+			"cross_account": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -65,8 +71,9 @@ func resourceDiscovererCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	sourceARN := d.Get("source_arn").(string)
 	input := &schemas.CreateDiscovererInput{
-		SourceArn: aws.String(sourceARN),
-		Tags:      getTagsIn(ctx),
+		CrossAccount: aws.Bool(d.Get("cross_account").(bool)),
+		SourceArn:    aws.String(sourceARN),
+		Tags:         getTagsIn(ctx),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -102,6 +109,7 @@ func resourceDiscovererRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set("arn", output.DiscovererArn)
+	d.Set("cross_account", output.CrossAccount)
 	d.Set("description", output.Description)
 	d.Set("source_arn", output.SourceArn)
 
@@ -112,8 +120,9 @@ func resourceDiscovererUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchemasConn(ctx)
 
-	if d.HasChange("description") {
+	if d.HasChanges("cross_account", "description") {
 		input := &schemas.UpdateDiscovererInput{
+			CrossAccount: aws.Bool(d.Get("cross_account").(bool)),
 			DiscovererId: aws.String(d.Id()),
 			Description:  aws.String(d.Get("description").(string)),
 		}
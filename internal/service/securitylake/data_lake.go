@@ -0,0 +1,541 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_securitylake_data_lake", name="Data Lake")
+// @Tags(identifierAttribute="arn")
+func ResourceDataLake() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataLakeCreate,
+		ReadWithoutTimeout:   resourceDataLakeRead,
+		UpdateWithoutTimeout: resourceDataLakeUpdate,
+		DeleteWithoutTimeout: resourceDataLakeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  dataLakeS3ManagedKey,
+									},
+								},
+							},
+						},
+						"lifecycle_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"transition": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"replication_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"regions": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidRegionName,
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"meta_store_manager_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_bucket_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	dataLakeS3ManagedKey = "S3_MANAGED_KEY"
+)
+
+func resourceDataLakeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	region := meta.(*conns.AWSClient).Region
+	input := &securitylake.CreateDataLakeInput{
+		MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configurations = []types.DataLakeConfiguration{*expandDataLakeConfiguration(v.([]interface{})[0].(map[string]interface{}), region)}
+	}
+
+	output, err := conn.CreateDataLake(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Lake Data Lake (%s): %s", region, err)
+	}
+
+	if len(output.DataLakes) == 0 {
+		return sdkdiag.AppendErrorf(diags, "creating Security Lake Data Lake (%s): empty result", region)
+	}
+
+	d.SetId(aws.ToString(output.DataLakes[0].DataLakeArn))
+
+	if _, err := waitDataLakeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataLakeRead(ctx, d, meta)...)
+}
+
+func resourceDataLakeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	dataLake, err := FindDataLakeByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Data Lake (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataLake.DataLakeArn)
+	if err := d.Set("configuration", []interface{}{flattenDataLakeResource(dataLake)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set("s3_bucket_arn", dataLake.S3BucketArn)
+
+	return diags
+}
+
+func resourceDataLakeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	if d.HasChange("configuration") {
+		region, err := dataLakeRegion(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Security Lake Data Lake (%s): %s", d.Id(), err)
+		}
+
+		input := &securitylake.UpdateDataLakeInput{}
+
+		if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Configurations = []types.DataLakeConfiguration{*expandDataLakeConfiguration(v.([]interface{})[0].(map[string]interface{}), region)}
+		}
+
+		_, err = conn.UpdateDataLake(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Security Lake Data Lake (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataLakeRead(ctx, d, meta)...)
+}
+
+func resourceDataLakeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient(ctx)
+
+	region, err := dataLakeRegion(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Security Lake Data Lake: %s", d.Id())
+	_, err = conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+		Regions: []string{region},
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// dataLakeRegion returns the AWS Region of the data lake with the specified ARN.
+func dataLakeRegion(dataLakeARN string) (string, error) {
+	parsedARN, err := arn.Parse(dataLakeARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	return parsedARN.Region, nil
+}
+
+func FindDataLakeByARN(ctx context.Context, conn *securitylake.Client, dataLakeARN string) (*types.DataLakeResource, error) {
+	region, err := dataLakeRegion(dataLakeARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.ToString(v.DataLakeArn) == dataLakeARN {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func statusDataLakeCreate(ctx context.Context, conn *securitylake.Client, dataLakeARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataLakeByARN(ctx, conn, dataLakeARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CreateStatus), nil
+	}
+}
+
+func statusDataLakeUpdate(ctx context.Context, conn *securitylake.Client, dataLakeARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataLakeByARN(ctx, conn, dataLakeARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.UpdateStatus == nil {
+			return output, string(types.DataLakeStatusCompleted), nil
+		}
+
+		return output, string(output.UpdateStatus.Status), nil
+	}
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending),
+		Target:  enum.Slice(types.DataLakeStatusCompleted),
+		Refresh: statusDataLakeCreate(ctx, conn, dataLakeARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending),
+		Target:  enum.Slice(types.DataLakeStatusCompleted),
+		Refresh: statusDataLakeUpdate(ctx, conn, dataLakeARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		if status := output.UpdateStatus; status != nil && status.Exception != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(status.Exception.Code), aws.ToString(status.Exception.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending, types.DataLakeStatusCompleted),
+		Target:  []string{},
+		Refresh: statusDataLakeCreate(ctx, conn, dataLakeARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDataLakeConfiguration(tfMap map[string]interface{}, region string) *types.DataLakeConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DataLakeConfiguration{
+		Region: aws.String(region),
+	}
+
+	if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.EncryptionConfiguration = &types.DataLakeEncryptionConfiguration{
+			KmsKeyId: aws.String(tfMap["kms_key_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["lifecycle_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LifecycleConfiguration = expandDataLakeLifecycleConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["replication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ReplicationConfiguration = &types.DataLakeReplicationConfiguration{
+			Regions: flex.ExpandStringValueSet(tfMap["regions"].(*schema.Set)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandDataLakeLifecycleConfiguration(tfMap map[string]interface{}) *types.DataLakeLifecycleConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DataLakeLifecycleConfiguration{}
+
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Expiration = &types.DataLakeLifecycleExpiration{
+			Days: aws.Int32(int32(tfMap["days"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Transitions = append(apiObject.Transitions, types.DataLakeLifecycleTransition{
+				Days:         aws.Int32(int32(tfMap["days"].(int))),
+				StorageClass: aws.String(tfMap["storage_class"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDataLakeResource(apiObject *types.DataLakeResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"kms_key_id": aws.ToString(v.KmsKeyId),
+		}}
+	}
+
+	if v := apiObject.LifecycleConfiguration; v != nil {
+		tfMap["lifecycle_configuration"] = flattenDataLakeLifecycleConfiguration(v)
+	}
+
+	if v := apiObject.ReplicationConfiguration; v != nil && len(v.Regions) > 0 {
+		tfMap["replication_configuration"] = []interface{}{map[string]interface{}{
+			"regions":  v.Regions,
+			"role_arn": aws.ToString(v.RoleArn),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDataLakeLifecycleConfiguration(apiObject *types.DataLakeLifecycleConfiguration) []interface{} {
+	if apiObject == nil || (apiObject.Expiration == nil && len(apiObject.Transitions) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Expiration; v != nil {
+		tfMap["expiration"] = []interface{}{map[string]interface{}{
+			"days": aws.ToInt32(v.Days),
+		}}
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Transitions {
+		tfList = append(tfList, map[string]interface{}{
+			"days":          aws.ToInt32(v.Days),
+			"storage_class": aws.ToString(v.StorageClass),
+		})
+	}
+
+	tfMap["transition"] = tfList
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSecurityLake_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"DataLake": {
+			"basic":       testAccDataLake_basic,
+			"disappears":  testAccDataLake_disappears,
+			"lifecycle":   testAccDataLake_lifecycle,
+			"replication": testAccDataLake_replication,
+			"tags":        testAccDataLake_tags,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
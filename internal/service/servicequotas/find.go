@@ -100,3 +100,60 @@ func findServiceQuotaByID(ctx context.Context, conn *servicequotas.ServiceQuotas
 
 	return output.Quota, nil
 }
+
+func FindTemplateByThreePartKey(ctx context.Context, conn *servicequotas.ServiceQuotas, region, quotaCode, serviceCode string) (*servicequotas.ServiceQuotaIncreaseRequestInTemplate, error) {
+	input := &servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	output, err := conn.GetServiceQuotaIncreaseRequestFromTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceQuotaIncreaseRequestInTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceQuotaIncreaseRequestInTemplate, nil
+}
+
+func FindTemplateAssociation(ctx context.Context, conn *servicequotas.ServiceQuotas) (string, error) {
+	input := &servicequotas.GetAssociationForServiceQuotaTemplateInput{}
+
+	output, err := conn.GetAssociationForServiceQuotaTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.ServiceQuotaTemplateAssociationStatus); status != servicequotas.ServiceQuotaTemplateAssociationStatusAssociated {
+		return "", &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return aws.StringValue(output.ServiceQuotaTemplateAssociationStatus), nil
+}
@@ -58,6 +58,10 @@ func ResourceServiceQuota() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"request_case_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -231,6 +235,7 @@ func resourceServiceQuotaRead(ctx context.Context, d *schema.ResourceData, meta
 		output, err := conn.GetRequestedServiceQuotaChangeWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+			d.Set("request_case_id", "")
 			d.Set("request_id", "")
 			d.Set("request_status", "")
 			return diags
@@ -245,6 +250,7 @@ func resourceServiceQuotaRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		requestStatus := aws.StringValue(output.RequestedQuota.Status)
+		d.Set("request_case_id", output.RequestedQuota.CaseId)
 		d.Set("request_status", requestStatus)
 
 		switch requestStatus {
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_servicequotas_template_association")
+func ResourceTemplateAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateAssociationCreate,
+		ReadWithoutTimeout:   resourceTemplateAssociationRead,
+		DeleteWithoutTimeout: resourceTemplateAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTemplateAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn(ctx)
+
+	_, err := conn.AssociateServiceQuotaTemplateWithContext(ctx, &servicequotas.AssociateServiceQuotaTemplateInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Service Quotas Template Association: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return append(diags, resourceTemplateAssociationRead(ctx, d, meta)...)
+}
+
+func resourceTemplateAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn(ctx)
+
+	status, err := FindTemplateAssociation(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Quotas Template Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Quotas Template Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("status", status)
+
+	return diags
+}
+
+func resourceTemplateAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Service Quotas Template Association: %s", d.Id())
+	_, err := conn.DisassociateServiceQuotaTemplateWithContext(ctx, &servicequotas.DisassociateServiceQuotaTemplateInput{})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Quotas Template Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
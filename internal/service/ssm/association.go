@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -59,6 +60,11 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"instance_id": {
 				Type:       schema.TypeString,
 				ForceNew:   true,
@@ -97,6 +103,11 @@ func ResourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 256),
 			},
+			"schedule_offset": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
 			"output_location": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -121,6 +132,45 @@ func ResourceAssociation() *schema.Resource {
 					},
 				},
 			},
+			"target_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 			"targets": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -190,6 +240,14 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.ScheduleExpression = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		associationInput.ScheduleOffset = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		associationInput.Parameters = expandDocumentParameters(v.(map[string]interface{}))
 	}
@@ -202,6 +260,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.OutputLocation = expandAssociationOutputLocation(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("compliance_severity"); ok {
 		associationInput.ComplianceSeverity = aws.String(v.(string))
 	}
@@ -270,6 +332,8 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("name", association.Name)
 	d.Set("association_id", association.AssociationId)
 	d.Set("schedule_expression", association.ScheduleExpression)
+	d.Set("schedule_offset", association.ScheduleOffset)
+	d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames))
 	d.Set("document_version", association.DocumentVersion)
 	d.Set("compliance_severity", association.ComplianceSeverity)
 	d.Set("max_concurrency", association.MaxConcurrency)
@@ -288,6 +352,10 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting output_location error: %s", err)
 	}
 
+	if err := d.Set("target_locations", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_locations error: %s", err)
+	}
+
 	return diags
 }
 
@@ -318,6 +386,14 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.ScheduleExpression = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		associationInput.ScheduleOffset = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		associationInput.Parameters = expandDocumentParameters(v.(map[string]interface{}))
 	}
@@ -330,6 +406,10 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.OutputLocation = expandAssociationOutputLocation(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("compliance_severity"); ok {
 		associationInput.ComplianceSeverity = aws.String(v.(string))
 	}
@@ -434,3 +514,65 @@ func flattenAssociationOutputLocation(location *ssm.InstanceAssociationOutputLoc
 
 	return result
 }
+
+func expandTargetLocations(tfList []interface{}) []*ssm.TargetLocation {
+	var apiObjects []*ssm.TargetLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.TargetLocation{}
+
+		if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Accounts = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["execution_role_name"].(string); ok && v != "" {
+			apiObject.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Regions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["target_location_max_concurrency"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_location_max_errors"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetLocations(apiObjects []*ssm.TargetLocation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"accounts":                        aws.StringValueSlice(apiObject.Accounts),
+			"execution_role_name":             aws.StringValue(apiObject.ExecutionRoleName),
+			"regions":                         aws.StringValueSlice(apiObject.Regions),
+			"target_location_max_concurrency": aws.StringValue(apiObject.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.StringValue(apiObject.TargetLocationMaxErrors),
+		})
+	}
+
+	return tfList
+}
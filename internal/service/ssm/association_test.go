@@ -536,6 +536,85 @@ func TestAccSSMAssociation_withScheduleExpression(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_withScheduleOffsetAndCalendar(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_scheduleOffsetAndCalendar(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "2"),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_scheduleOffsetAndCalendar(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_withTargetLocations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "1", "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_locations.0.accounts.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.regions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_locations.0.regions.*", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_locations.0.execution_role_name", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_errors", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "10%", "5%"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "10%"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_errors", "5%"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMAssociation_withComplianceSeverity(t *testing.T) {
 	ctx := acctest.Context(t)
 	assocName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1490,6 +1569,113 @@ resource "aws_ssm_association" "test" {
 `, rName, associationName, scheduleExpression)
 }
 
+func testAccAssociationConfig_scheduleOffsetAndCalendar(rName string, scheduleOffset int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_document" "calendar" {
+  name            = "%[1]s-calendar"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:
+BEGIN:VTODO
+DTSTAMP:20200320T004207Z
+UID:3b5af39a-d0b3-4049-a839-d7bb8af01f92
+SUMMARY:Add events to this calendar.
+END:VTODO
+END:VCALENDAR
+DOC
+}
+
+resource "aws_ssm_association" "test" {
+  name                = aws_ssm_document.test.name
+  schedule_expression = "cron(0 16 ? * TUE#3 *)"
+  schedule_offset     = %[2]d
+  calendar_names      = [aws_ssm_document.calendar.name]
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, scheduleOffset)
+}
+
+func testAccAssociationConfig_targetLocations(rName, maxConcurrency, maxErrors string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ssm.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_ssm_association" "test" {
+  name                             = "AWS-StopEC2Instance"
+  automation_target_parameter_name = "InstanceId"
+  schedule_expression              = "cron(0 16 ? * TUE *)"
+  apply_only_at_cron_interval      = true
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  target_locations {
+    accounts                        = [data.aws_caller_identity.current.account_id]
+    regions                         = [data.aws_region.current.name]
+    execution_role_name             = aws_iam_role.test.name
+    target_location_max_concurrency = %[2]q
+    target_location_max_errors      = %[3]q
+  }
+}
+`, rName, maxConcurrency, maxErrors)
+}
+
 func testAccAssociationConfig_basicComplianceSeverity(compSeverity, rName, assocName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -58,3 +58,49 @@ func flattenTargets(targets []*ssm.Target) []map[string]interface{} {
 
 	return result
 }
+
+func expandAlarmConfiguration(tfList []interface{}) *ssm.AlarmConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ssm.AlarmConfiguration{
+		IgnorePollAlarmFailure: aws.Bool(tfMap["ignore_poll_alarm_failure"].(bool)),
+	}
+
+	for _, tfMapRaw := range tfMap["alarm"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Alarms = append(apiObject.Alarms, &ssm.Alarm{
+			Name: aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenAlarmConfiguration(apiObject *ssm.AlarmConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	alarms := make([]interface{}, 0, len(apiObject.Alarms))
+
+	for _, alarm := range apiObject.Alarms {
+		alarms = append(alarms, map[string]interface{}{
+			"name": aws.StringValue(alarm.Name),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"alarm":                     alarms,
+		"ignore_poll_alarm_failure": aws.BoolValue(apiObject.IgnorePollAlarmFailure),
+	}
+
+	return []interface{}{tfMap}
+}
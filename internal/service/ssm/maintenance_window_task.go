@@ -50,6 +50,35 @@ func ResourceMaintenanceWindowTask() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"alarm_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+						"ignore_poll_alarm_failure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"cutoff_behavior": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -698,6 +727,10 @@ func resourceMaintenanceWindowTaskCreate(ctx context.Context, d *schema.Resource
 		params.CutoffBehavior = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("alarm_configuration"); ok {
+		params.AlarmConfiguration = expandAlarmConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("targets"); ok {
 		params.Targets = expandTargets(v.([]interface{}))
 	}
@@ -764,6 +797,10 @@ func resourceMaintenanceWindowTaskRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("description", resp.Description)
 	d.Set("cutoff_behavior", resp.CutoffBehavior)
 
+	if err := d.Set("alarm_configuration", flattenAlarmConfiguration(resp.AlarmConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_configuration: %s", err)
+	}
+
 	if resp.TaskInvocationParameters != nil {
 		if err := d.Set("task_invocation_parameters", flattenTaskInvocationParameters(resp.TaskInvocationParameters)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting task_invocation_parameters error: %#v", err)
@@ -822,6 +859,10 @@ func resourceMaintenanceWindowTaskUpdate(ctx context.Context, d *schema.Resource
 		params.CutoffBehavior = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("alarm_configuration"); ok {
+		params.AlarmConfiguration = expandAlarmConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		params.Name = aws.String(v.(string))
 	}
@@ -0,0 +1,577 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// opsItemRelatedResourcesKey is the operational data key OpsCenter uses for an OpsItem's related resources.
+	opsItemRelatedResourcesKey = "/aws/resources"
+)
+
+// @SDKResource("aws_ssm_ops_item", name="OpsItem")
+// @Tags(identifierAttribute="id", resourceType="OpsItem")
+func ResourceOpsItem() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOpsItemCreate,
+		ReadWithoutTimeout:   resourceOpsItemRead,
+		UpdateWithoutTimeout: resourceOpsItemUpdate,
+		DeleteWithoutTimeout: resourceOpsItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Availability", "Cost", "Performance", "Recovery", "Security"}, false),
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"operational_data": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringNotInSlice([]string{opsItemRelatedResourcesKey}, false),
+							),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.OpsItemDataTypeSearchableString,
+							ValidateFunc: validation.StringInSlice(ssm.OpsItemDataType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ops_item_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"related_ops_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"related_resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "2", "3", "4"}, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{ssm.OpsItemStatusInProgress, ssm.OpsItemStatusOpen, ssm.OpsItemStatusResolved}, false),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	title := d.Get("title").(string)
+	input := &ssm.CreateOpsItemInput{
+		Description: aws.String(d.Get("description").(string)),
+		Source:      aws.String(d.Get("source").(string)),
+		Tags:        getTagsIn(ctx),
+		Title:       aws.String(title),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.Notifications = expandOpsItemNotifications(v.(*schema.Set).List())
+	}
+
+	operationalData, err := expandOpsItemOperationalData(d.Get("operational_data").(*schema.Set).List(), d.Get("related_resources").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsItem (%s): %s", title, err)
+	}
+
+	if len(operationalData) > 0 {
+		input.OperationalData = operationalData
+	}
+
+	if v, ok := d.GetOk("ops_item_type"); ok {
+		input.OpsItemType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("related_ops_item_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.RelatedOpsItems = expandRelatedOpsItems(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateOpsItemWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsItem (%s): %s", title, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsItemId))
+
+	// New OpsItems are always Open.
+	if v, ok := d.GetOk("status"); ok && v.(string) != ssm.OpsItemStatusOpen {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+			Status:    aws.String(v.(string)),
+		}
+
+		if _, err := conn.UpdateOpsItemWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsItemRead(ctx, d, meta)...)
+}
+
+func resourceOpsItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	output, err := FindOpsItemByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsItem %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	if v := aws.StringValue(output.OpsItemArn); v != "" {
+		d.Set("arn", v)
+	} else {
+		arn := arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "ssm",
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Resource:  fmt.Sprintf("opsitem/%s", d.Id()),
+		}.String()
+		d.Set("arn", arn)
+	}
+	d.Set("category", output.Category)
+	d.Set("created_by", output.CreatedBy)
+	if output.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(output.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("last_modified_by", output.LastModifiedBy)
+	if output.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(output.LastModifiedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	if err := d.Set("notification_arns", flattenOpsItemNotifications(output.Notifications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting notification_arns: %s", err)
+	}
+	operationalData, relatedResources, err := flattenOpsItemOperationalData(output.OperationalData)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsItem (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("operational_data", operationalData); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting operational_data: %s", err)
+	}
+	d.Set("ops_item_type", output.OpsItemType)
+	d.Set("priority", output.Priority)
+	if err := d.Set("related_ops_item_ids", flattenRelatedOpsItems(output.RelatedOpsItems)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting related_ops_item_ids: %s", err)
+	}
+	d.Set("related_resources", relatedResources)
+	d.Set("severity", output.Severity)
+	d.Set("source", output.Source)
+	d.Set("status", output.Status)
+	d.Set("title", output.Title)
+	d.Set("version", output.Version)
+
+	return diags
+}
+
+func resourceOpsItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("category") {
+			input.Category = aws.String(d.Get("category").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("notification_arns") {
+			input.Notifications = expandOpsItemNotifications(d.Get("notification_arns").(*schema.Set).List())
+		}
+
+		if d.HasChanges("operational_data", "related_resources") {
+			o, n := d.GetChange("operational_data")
+			_, relatedResources := d.GetChange("related_resources")
+
+			operationalData, err := expandOpsItemOperationalData(n.(*schema.Set).List(), relatedResources.(*schema.Set).List())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s): %s", d.Id(), err)
+			}
+
+			if len(operationalData) > 0 {
+				input.OperationalData = operationalData
+			}
+
+			var keysToDelete []*string
+
+			for _, tfMapRaw := range o.(*schema.Set).List() {
+				key := tfMapRaw.(map[string]interface{})["key"].(string)
+
+				if _, ok := operationalData[key]; !ok {
+					keysToDelete = append(keysToDelete, aws.String(key))
+				}
+			}
+
+			if _, ok := operationalData[opsItemRelatedResourcesKey]; !ok && d.HasChange("related_resources") {
+				keysToDelete = append(keysToDelete, aws.String(opsItemRelatedResourcesKey))
+			}
+
+			input.OperationalDataToDelete = keysToDelete
+		}
+
+		if d.HasChange("priority") {
+			if v, ok := d.GetOk("priority"); ok {
+				input.Priority = aws.Int64(int64(v.(int)))
+			}
+		}
+
+		if d.HasChange("related_ops_item_ids") {
+			input.RelatedOpsItems = expandRelatedOpsItems(d.Get("related_ops_item_ids").(*schema.Set).List())
+		}
+
+		if d.HasChange("severity") {
+			input.Severity = aws.String(d.Get("severity").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if d.HasChange("title") {
+			input.Title = aws.String(d.Get("title").(string))
+		}
+
+		_, err := conn.UpdateOpsItemWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsItemRead(ctx, d, meta)...)
+}
+
+func resourceOpsItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	// OpsItems can't be deleted, only resolved.
+	output, err := FindOpsItemByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.Status) == ssm.OpsItemStatusResolved {
+		return diags
+	}
+
+	log.Printf("[INFO] Resolving SSM OpsItem: %s", d.Id())
+	_, err = conn.UpdateOpsItemWithContext(ctx, &ssm.UpdateOpsItemInput{
+		OpsItemId: aws.String(d.Id()),
+		Status:    aws.String(ssm.OpsItemStatusResolved),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resolving SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindOpsItemByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.OpsItem, error) {
+	input := &ssm.GetOpsItemInput{
+		OpsItemId: aws.String(id),
+	}
+
+	output, err := conn.GetOpsItemWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OpsItem == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OpsItem, nil
+}
+
+type opsItemRelatedResource struct {
+	ARN string `json:"arn"`
+}
+
+func expandOpsItemOperationalData(tfList []interface{}, relatedResources []interface{}) (map[string]*ssm.OpsItemDataValue, error) {
+	apiObject := make(map[string]*ssm.OpsItemDataValue)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject[tfMap["key"].(string)] = &ssm.OpsItemDataValue{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+	}
+
+	if len(relatedResources) > 0 {
+		resources := make([]opsItemRelatedResource, 0, len(relatedResources))
+
+		for _, v := range relatedResources {
+			resources = append(resources, opsItemRelatedResource{ARN: v.(string)})
+		}
+
+		b, err := json.Marshal(resources)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject[opsItemRelatedResourcesKey] = &ssm.OpsItemDataValue{
+			Type:  aws.String(ssm.OpsItemDataTypeSearchableString),
+			Value: aws.String(string(b)),
+		}
+	}
+
+	return apiObject, nil
+}
+
+func flattenOpsItemOperationalData(apiObject map[string]*ssm.OpsItemDataValue) ([]interface{}, []string, error) {
+	var tfList []interface{}
+	var relatedResources []string
+
+	for k, v := range apiObject {
+		if v == nil {
+			continue
+		}
+
+		if k == opsItemRelatedResourcesKey {
+			var resources []opsItemRelatedResource
+
+			if err := json.Unmarshal([]byte(aws.StringValue(v.Value)), &resources); err != nil {
+				return nil, nil, fmt.Errorf("decoding related resources: %w", err)
+			}
+
+			for _, resource := range resources {
+				relatedResources = append(relatedResources, resource.ARN)
+			}
+
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"type":  aws.StringValue(v.Type),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+
+	return tfList, relatedResources, nil
+}
+
+func expandOpsItemNotifications(tfList []interface{}) []*ssm.OpsItemNotification {
+	apiObjects := make([]*ssm.OpsItemNotification, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringValueList(tfList) {
+		apiObjects = append(apiObjects, &ssm.OpsItemNotification{
+			Arn: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemNotifications(apiObjects []*ssm.OpsItemNotification) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.Arn))
+	}
+
+	return tfList
+}
+
+func expandRelatedOpsItems(tfList []interface{}) []*ssm.RelatedOpsItem {
+	apiObjects := make([]*ssm.RelatedOpsItem, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringValueList(tfList) {
+		apiObjects = append(apiObjects, &ssm.RelatedOpsItem{
+			OpsItemId: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRelatedOpsItems(apiObjects []*ssm.RelatedOpsItem) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.OpsItemId))
+	}
+
+	return tfList
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMOpsItem_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var opsItem ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm", regexp.MustCompile(`opsitem/oi-[0-9a-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "notification_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ops_item_type", "/aws/issue"),
+					resource.TestCheckResourceAttr(resourceName, "related_ops_item_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "related_resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Open"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var opsItem ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_full(rName, "Security", "2", "Open", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "category", "Security"),
+					resource.TestCheckResourceAttr(resourceName, "notification_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_arns.*", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "remediation",
+						"type":  "SearchableString",
+						"value": "value1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "related_resources.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "related_resources.*", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Open"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_full(rName, "Availability", "3", "InProgress", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "category", "Availability"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "remediation",
+						"value": "value2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "3"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status", "InProgress"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfig_full(rName, "Availability", "3", "Resolved", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "status", "Resolved"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "notification_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "related_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var opsItem ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOpsItemExists(ctx context.Context, n string, v *ssm.OpsItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM OpsItem ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		output, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// OpsItems can't be deleted, so destroying the resource resolves the OpsItem.
+func testAccCheckOpsItemDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_ops_item" {
+				continue
+			}
+
+			output, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if status := aws.StringValue(output.Status); status != ssm.OpsItemStatusResolved {
+				return fmt.Errorf("SSM OpsItem %s still exists with status %s", rs.Primary.ID, status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccOpsItemConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Terraform acceptance test"
+  source      = "terraform"
+}
+`, rName)
+}
+
+func testAccOpsItemConfig_full(rName, category, severity, status, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Terraform acceptance test"
+  source      = "terraform"
+  category    = %[2]q
+  severity    = %[3]q
+  priority    = %[3]s
+  status      = %[4]q
+
+  notification_arns = [aws_sns_topic.test.arn]
+  related_resources = [aws_sns_topic.test.arn]
+
+  operational_data {
+    key   = "remediation"
+    value = %[5]q
+  }
+}
+`, rName, category, severity, status, value)
+}
+
+func testAccOpsItemConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Terraform acceptance test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOpsItemConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "Terraform acceptance test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
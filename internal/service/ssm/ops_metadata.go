@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssm_ops_metadata", name="OpsMetadata")
+// @Tags(identifierAttribute="id", resourceType="OpsMetadata")
+func ResourceOpsMetadata() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOpsMetadataCreate,
+		ReadWithoutTimeout:   resourceOpsMetadataRead,
+		UpdateWithoutTimeout: resourceOpsMetadataUpdate,
+		DeleteWithoutTimeout: resourceOpsMetadataDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 4096),
+				},
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsMetadataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	resourceID := d.Get("resource_id").(string)
+	input := &ssm.CreateOpsMetadataInput{
+		ResourceId: aws.String(resourceID),
+		Tags:       getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Metadata = expandOpsMetadata(v.(map[string]interface{}))
+	}
+
+	output, err := conn.CreateOpsMetadataWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsMetadata (%s): %s", resourceID, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsMetadataArn))
+
+	return append(diags, resourceOpsMetadataRead(ctx, d, meta)...)
+}
+
+func resourceOpsMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	output, err := FindOpsMetadataByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsMetadata %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsMetadata (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("metadata", flattenOpsMetadata(output.Metadata))
+	d.Set("resource_id", output.ResourceId)
+
+	return diags
+}
+
+func resourceOpsMetadataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		os, ns := o.(map[string]interface{}), n.(map[string]interface{})
+
+		input := &ssm.UpdateOpsMetadataInput{
+			OpsMetadataArn: aws.String(d.Id()),
+		}
+
+		update := make(map[string]interface{})
+		for k, v := range ns {
+			if ov, ok := os[k]; !ok || ov != v {
+				update[k] = v
+			}
+		}
+
+		if len(update) > 0 {
+			input.MetadataToUpdate = expandOpsMetadata(update)
+		}
+
+		for k := range os {
+			if _, ok := ns[k]; !ok {
+				input.KeysToDelete = append(input.KeysToDelete, aws.String(k))
+			}
+		}
+
+		_, err := conn.UpdateOpsMetadataWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsMetadata (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsMetadataRead(ctx, d, meta)...)
+}
+
+func resourceOpsMetadataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	log.Printf("[INFO] Deleting SSM OpsMetadata: %s", d.Id())
+	_, err := conn.DeleteOpsMetadataWithContext(ctx, &ssm.DeleteOpsMetadataInput{
+		OpsMetadataArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM OpsMetadata (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindOpsMetadataByARN returns the OpsMetadata object with the specified ARN, including all of its metadata keys.
+func FindOpsMetadataByARN(ctx context.Context, conn *ssm.SSM, arn string) (*ssm.GetOpsMetadataOutput, error) {
+	input := &ssm.GetOpsMetadataInput{
+		OpsMetadataArn: aws.String(arn),
+	}
+	var result *ssm.GetOpsMetadataOutput
+
+	for {
+		output, err := conn.GetOpsMetadataWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if result == nil {
+			result = &ssm.GetOpsMetadataOutput{
+				Metadata:   make(map[string]*ssm.MetadataValue),
+				ResourceId: output.ResourceId,
+			}
+		}
+
+		for k, v := range output.Metadata {
+			result.Metadata[k] = v
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return result, nil
+}
+
+func expandOpsMetadata(tfMap map[string]interface{}) map[string]*ssm.MetadataValue {
+	apiObject := make(map[string]*ssm.MetadataValue, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObject[k] = &ssm.MetadataValue{
+			Value: aws.String(v.(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenOpsMetadata(apiObject map[string]*ssm.MetadataValue) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(apiObject))
+
+	for k, v := range apiObject {
+		if v == nil {
+			continue
+		}
+
+		tfMap[k] = aws.StringValue(v.Value)
+	}
+
+	return tfMap
+}
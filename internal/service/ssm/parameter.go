@@ -5,6 +5,7 @@ package ssm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -91,6 +93,16 @@ func ResourceParameter() *schema.Resource {
 				Optional:   true,
 				Deprecated: "this attribute has been deprecated",
 			},
+			"policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					policies, _ := structure.NormalizeJsonString(v)
+					return policies
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tier": {
@@ -129,6 +141,7 @@ func ResourceParameter() *schema.Resource {
 			customdiff.ForceNewIfChange("tier", func(_ context.Context, old, new, meta interface{}) bool {
 				return old.(string) == ssm.ParameterTierAdvanced && new.(string) == ssm.ParameterTierStandard
 			}),
+			resourceParameterCustomizeDiff,
 			customdiff.ComputedIf("version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("value")
 			}),
@@ -175,6 +188,15 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("policies"); ok {
+		input.Policies = aws.String(v.(string))
+
+		// Without a configured tier the region's default tier, usually Standard, would be used and policies rejected.
+		if input.Tier == nil && v.(string) != "[]" {
+			input.Tier = aws.String(ssm.ParameterTierIntelligentTiering)
+		}
+	}
+
 	if keyID, ok := d.GetOk("key_id"); ok && d.Get("type").(string) == ssm.ParameterTypeSecureString {
 		input.SetKeyId(keyID.(string))
 	}
@@ -296,6 +318,12 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("allowed_pattern", detail.AllowedPattern)
 	d.Set("data_type", detail.DataType)
 
+	policies, err := flattenParameterPolicies(detail.Policies)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter (%s) policies: %s", d.Id(), err)
+	}
+	d.Set("policies", policies)
+
 	return diags
 }
 
@@ -332,6 +360,20 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			paramInput.Description = aws.String(d.Get("description").(string))
 		}
 
+		if d.HasChange("policies") {
+			// An empty list removes all policies.
+			policies := "[]"
+			if v, ok := d.GetOk("policies"); ok {
+				policies = v.(string)
+			}
+			paramInput.Policies = aws.String(policies)
+		}
+
+		// Without a configured tier, let Parameter Store move the parameter to the Advanced tier that policies require.
+		if v, ok := d.GetOk("policies"); ok && v.(string) != "[]" && (!tier.IsKnown() || tier.IsNull()) {
+			paramInput.Tier = aws.String(ssm.ParameterTierIntelligentTiering)
+		}
+
 		if d.HasChange("key_id") && d.Get("type").(string) == ssm.ParameterTypeSecureString {
 			paramInput.SetKeyId(d.Get("key_id").(string))
 		}
@@ -371,6 +413,51 @@ func resourceParameterDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceParameterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Parameter policies are only supported by advanced parameters. When no tier is configured,
+	// Intelligent-Tiering is requested instead of the region's default tier.
+	if v, ok := diff.GetOk("policies"); ok && v.(string) != "[]" {
+		tier := diff.GetRawConfig().GetAttr("tier")
+
+		if tier.IsKnown() && !tier.IsNull() && tier.AsString() == ssm.ParameterTierStandard {
+			return fmt.Errorf("policies require the %s or %s tier", ssm.ParameterTierAdvanced, ssm.ParameterTierIntelligentTiering)
+		}
+
+		if tier.IsNull() && diff.Id() != "" && diff.HasChange("policies") && diff.Get("tier").(string) == ssm.ParameterTierStandard {
+			if err := diff.SetNewComputed("tier"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// flattenParameterPolicies returns the parameter's policies as a JSON array.
+func flattenParameterPolicies(apiObjects []*ssm.ParameterInlinePolicy) (string, error) {
+	if len(apiObjects) == 0 {
+		return "", nil
+	}
+
+	policies := make([]json.RawMessage, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		policies = append(policies, json.RawMessage(aws.StringValue(apiObject.PolicyText)))
+	}
+
+	b, err := json.Marshal(policies)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
 func ShouldUpdateParameter(d *schema.ResourceData) bool {
 	// If the user has specified a preference, return their preference
 	if value, ok := d.GetOkExists("overwrite"); ok {
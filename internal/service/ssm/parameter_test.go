@@ -193,6 +193,85 @@ func TestAccSSMParameter_tier(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_policies(t *testing.T) {
+	ctx := acctest.Context(t)
+	var parameter ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterConfig_policies(rName, ssm.ParameterTierStandard, "Expiration", `{"Timestamp":"2099-01-01T00:00:00.000Z"}`),
+				ExpectError: regexp.MustCompile(`policies require the Advanced or Intelligent-Tiering tier`),
+			},
+			{
+				Config: testAccParameterConfig_policies(rName, ssm.ParameterTierAdvanced, "Expiration", `{"Timestamp":"2099-01-01T00:00:00.000Z"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policies", `[{"Type":"Expiration","Version":"1.0","Attributes":{"Timestamp":"2099-01-01T00:00:00.000Z"}}]`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+			{
+				Config: testAccParameterConfig_policies(rName, ssm.ParameterTierAdvanced, "NoChangeNotification", `{"After":"30","Unit":"Days"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policies", `[{"Type":"NoChangeNotification","Version":"1.0","Attributes":{"After":"30","Unit":"Days"}}]`),
+				),
+			},
+			{
+				Config: testAccParameterConfig_tier(rName, ssm.ParameterTierAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "policies", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameter_Policies_defaultTier(t *testing.T) {
+	ctx := acctest.Context(t)
+	var parameter ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_basic(rName, "String", "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierStandard),
+				),
+			},
+			{
+				Config: testAccParameterConfig_policiesNoTier(rName, "Expiration", `{"Timestamp":"2099-01-01T00:00:00.000Z"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policies", `[{"Type":"Expiration","Version":"1.0","Attributes":{"Timestamp":"2099-01-01T00:00:00.000Z"}}]`),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_Tier_intelligentTieringToStandard(t *testing.T) {
 	ctx := acctest.Context(t)
 	var parameter ssm.Parameter
@@ -1148,6 +1227,39 @@ resource "aws_ssm_parameter" "test" {
 `, rName, tier)
 }
 
+func testAccParameterConfig_policies(rName, tier, policyType, attributes string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([{
+    Type       = %[3]q
+    Version    = "1.0"
+    Attributes = jsondecode(%[4]q)
+  }])
+}
+`, rName, tier, policyType, attributes)
+}
+
+func testAccParameterConfig_policiesNoTier(rName, policyType, attributes string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([{
+    Type       = %[2]q
+    Version    = "1.0"
+    Attributes = jsondecode(%[3]q)
+  }])
+}
+`, rName, policyType, attributes)
+}
+
 func testAccParameterConfig_tierWithValue(rName, tier, value string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
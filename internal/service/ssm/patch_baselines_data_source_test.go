@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMPatchBaselinesDataSource_predefined(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_baselines.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselinesDataSourceConfig_predefined(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.baseline_name", "AWS-AmazonLinux2DefaultPatchBaseline"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.default_baseline", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.operating_system", "AMAZON_LINUX_2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "baseline_identities.0.baseline_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMPatchBaselinesDataSource_namePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_baselines.test"
+	resourceName := "aws_ssm_patch_baseline.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselinesDataSourceConfig_namePrefix(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_description", resourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.default_baseline", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.operating_system", resourceName, "operating_system"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatchBaselinesDataSourceConfig_predefined() string {
+	return `
+data "aws_ssm_patch_baselines" "test" {
+  operating_system  = "AMAZON_LINUX_2"
+  default_baselines = true
+
+  filter {
+    key    = "OWNER"
+    values = ["AWS"]
+  }
+}
+`
+}
+
+func testAccPatchBaselinesDataSourceConfig_namePrefix(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  description      = "Test baseline"
+  operating_system = "AMAZON_LINUX_2"
+  approved_patches = ["KB123456"]
+}
+
+data "aws_ssm_patch_baselines" "test" {
+  filter {
+    key    = "OWNER"
+    values = ["Self"]
+  }
+
+  filter {
+    key    = "NAME_PREFIX"
+    values = [aws_ssm_patch_baseline.test.name]
+  }
+}
+`, rName)
+}
@@ -51,6 +51,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
 		},
+		{
+			Factory:  DataSourcePatchBaselines,
+			TypeName: "aws_ssm_patch_baselines",
+		},
 	}
 }
 
@@ -96,6 +100,24 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceMaintenanceWindowTask,
 			TypeName: "aws_ssm_maintenance_window_task",
 		},
+		{
+			Factory:  ResourceOpsItem,
+			TypeName: "aws_ssm_ops_item",
+			Name:     "OpsItem",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+				ResourceType:        "OpsItem",
+			},
+		},
+		{
+			Factory:  ResourceOpsMetadata,
+			TypeName: "aws_ssm_ops_metadata",
+			Name:     "OpsMetadata",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+				ResourceType:        "OpsMetadata",
+			},
+		},
 		{
 			Factory:  ResourceParameter,
 			TypeName: "aws_ssm_parameter",
@@ -64,6 +64,23 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 		return
 	}
 
+	// In offline mode the caller's identity is not available, only the configured account ID.
+	if meta := d.Meta(); meta.AccountIDConfigured() {
+		data.AccountID = types.StringValue(meta.AccountID)
+		data.ARN = types.StringValue("")
+		data.ID = types.StringValue(meta.AccountID)
+		data.UserID = types.StringValue("")
+
+		response.Diagnostics.AddWarning(
+			"STS Caller Identity not available",
+			"The provider's account_id argument is set, so STS is not called. Only account_id and id are populated; arn and user_id are empty.",
+		)
+
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+
+		return
+	}
+
 	conn := d.Meta().STSConn(ctx)
 
 	output, err := FindCallerIdentity(ctx, conn)
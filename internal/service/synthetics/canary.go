@@ -43,6 +43,11 @@ func ResourceCanary() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -259,6 +264,11 @@ func ResourceCanary() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_run_success": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"zip_file": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -349,9 +359,17 @@ func resourceCanaryCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	if d.Get("start_canary").(bool) {
+		started := time.Now()
+
 		if err := startCanary(ctx, d.Id(), conn); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating Synthetics Canary (%s): %s", name, err)
 		}
+
+		if d.Get("wait_for_run_success").(bool) {
+			if _, err := waitCanaryRunPassed(ctx, conn, d.Id(), started, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Synthetics Canary (%s): waiting for first run to pass: %s", name, err)
+			}
+		}
 	}
 
 	return append(diags, resourceCanaryRead(ctx, d, meta)...)
@@ -426,7 +444,7 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SyntheticsConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all", "start_canary") {
+	if d.HasChangesExcept("tags", "tags_all", "start_canary", "wait_for_run_success") {
 		input := &synthetics.UpdateCanaryInput{
 			Name: aws.String(d.Id()),
 		}
@@ -502,9 +520,17 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if d.Get("start_canary").(bool) {
+			started := time.Now()
+
 			if err := startCanary(ctx, d.Id(), conn); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): %s", d.Id(), err)
 			}
+
+			if d.Get("wait_for_run_success").(bool) {
+				if _, err := waitCanaryRunPassed(ctx, conn, d.Id(), started, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): waiting for first run to pass: %s", d.Id(), err)
+				}
+			}
 		}
 	}
 
@@ -262,6 +262,44 @@ func TestAccSyntheticsCanary_StartCanary_codeChanges(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_StartCanary_waitForRunSuccess(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, synthetics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_waitForRunSuccess(rName, "test-fixtures/lambdatest.zip"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_run_success", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.last_started"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "wait_for_run_success"},
+			},
+			{
+				Config: testAccCanaryConfig_waitForRunSuccess(rName, "test-fixtures/lambdatest_modified.zip"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_run_success", "true"),
+					testAccCheckCanaryIsStartedAfter(&conf1, &conf2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf synthetics.Canary
@@ -1027,6 +1065,28 @@ resource "aws_synthetics_canary" "test" {
 `, rName, state))
 }
 
+func testAccCanaryConfig_waitForRunSuccess(rName, zipFile string) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = %[2]q
+  start_canary         = true
+  wait_for_run_success = true
+  runtime_version      = "syn-nodejs-puppeteer-3.9"
+  delete_lambda        = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, zipFile))
+}
+
 func testAccCanaryConfig_basicS3Code(rName string) string {
 	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
 resource "aws_synthetics_canary" "test" {
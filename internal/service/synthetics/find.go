@@ -5,6 +5,7 @@ package synthetics
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/synthetics"
@@ -38,6 +39,44 @@ func FindCanaryByName(ctx context.Context, conn *synthetics.Synthetics, name str
 	return output.Canary, nil
 }
 
+// FindCanaryRunStartedSince returns the most recent run of the specified canary
+// that started at or after the specified time.
+func FindCanaryRunStartedSince(ctx context.Context, conn *synthetics.Synthetics, name string, since time.Time) (*synthetics.CanaryRun, error) {
+	input := &synthetics.GetCanaryRunsInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCanaryRunsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Canary runs are returned most recent first.
+	for _, run := range output.CanaryRuns {
+		if run == nil || run.Status == nil || run.Timeline == nil {
+			continue
+		}
+
+		if started := aws.TimeValue(run.Timeline.Started); !started.Before(since) {
+			return run, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
 func FindGroupByName(ctx context.Context, conn *synthetics.Synthetics, name string) (*synthetics.Group, error) {
 	input := &synthetics.GetGroupInput{
 		GroupIdentifier: aws.String(name),
@@ -5,6 +5,7 @@ package synthetics
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/synthetics"
@@ -27,3 +28,20 @@ func statusCanaryState(ctx context.Context, conn *synthetics.Synthetics, name st
 		return output, aws.StringValue(output.Status.State), nil
 	}
 }
+
+func statusCanaryRun(ctx context.Context, conn *synthetics.Synthetics, name string, since time.Time) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCanaryRunStartedSince(ctx, conn, name, since)
+
+		// No run has started yet.
+		if tfresource.NotFound(err) {
+			return &synthetics.CanaryRun{}, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
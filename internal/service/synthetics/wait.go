@@ -95,6 +95,28 @@ func waitCanaryRunning(ctx context.Context, conn *synthetics.Synthetics, name st
 	return nil, err
 }
 
+func waitCanaryRunPassed(ctx context.Context, conn *synthetics.Synthetics, name string, since time.Time, timeout time.Duration) (*synthetics.CanaryRun, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"", synthetics.CanaryRunStateRunning},
+		Target:  []string{synthetics.CanaryRunStatePassed},
+		Refresh: statusCanaryRun(ctx, conn, name, since),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*synthetics.CanaryRun); ok {
+		if status := output.Status; status != nil && aws.StringValue(status.State) == synthetics.CanaryRunStateFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(status.StateReasonCode), aws.StringValue(status.StateReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitCanaryDeleted(ctx context.Context, conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{synthetics.CanaryStateDeleting, synthetics.CanaryStateStopped},
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"time"
+)
+
+const (
+	organizationStateActive   = "Active"
+	organizationStateCreating = "Creating"
+	organizationStateDeleted  = "Deleted"
+	organizationStateDeleting = "Deleting"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindOrganizationByID(ctx context.Context, conn *workmail.WorkMail, id string) (*workmail.DescribeOrganizationOutput, error) {
+	input := &workmail.DescribeOrganizationInput{
+		OrganizationId: aws.String(id),
+	}
+
+	output, err := conn.DescribeOrganizationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeOrganizationNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == organizationStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindUserByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, userID string) (*workmail.DescribeUserOutput, error) {
+	input := &workmail.DescribeUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	}
+
+	output, err := conn.DescribeUserWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == workmail.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindGroupByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, groupID string) (*workmail.DescribeGroupOutput, error) {
+	input := &workmail.DescribeGroupInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.DescribeGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == workmail.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindResourceByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, resourceID string) (*workmail.DescribeResourceOutput, error) {
+	input := &workmail.DescribeResourceInput{
+		OrganizationId: aws.String(organizationID),
+		ResourceId:     aws.String(resourceID),
+	}
+
+	output, err := conn.DescribeResourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == workmail.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindMailDomainByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, domainName string) (*workmail.GetMailDomainOutput, error) {
+	input := &workmail.GetMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.GetMailDomainWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeMailDomainNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
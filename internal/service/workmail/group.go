@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_workmail_group", name="Group")
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupCreate,
+		ReadWithoutTimeout:   resourceGroupRead,
+		UpdateWithoutTimeout: resourceGroupUpdate,
+		DeleteWithoutTimeout: resourceGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateGroupInput{
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.CreateGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkMail Group (%s): %s", name, err)
+	}
+
+	groupID := aws.StringValue(output.GroupId)
+	d.SetId(GroupCreateResourceID(organizationID, groupID))
+
+	if v, ok := d.GetOk("email"); ok {
+		if err := registerToWorkMail(ctx, conn, organizationID, groupID, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "registering WorkMail Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGroupRead(ctx, d, meta)...)
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	group, err := FindGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkMail Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("email", group.Email)
+	d.Set("group_id", group.GroupId)
+	d.Set("name", group.Name)
+	d.Set("organization_id", organizationID)
+	d.Set("state", group.State)
+
+	return diags
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange("email") {
+		o, n := d.GetChange("email")
+
+		if err := updateEntityEmail(ctx, conn, organizationID, groupID, o.(string), n.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkMail Group (%s) email: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGroupRead(ctx, d, meta)...)
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A group must be deregistered before it can be deleted.
+	if d.Get("state").(string) == workmail.EntityStateEnabled {
+		if err := deregisterFromWorkMail(ctx, conn, organizationID, groupID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deregistering WorkMail Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Group: %s", d.Id())
+	_, err = conn.DeleteGroupWithContext(ctx, &workmail.DeleteGroupInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkMail Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const groupResourceIDSeparator = ","
+
+func GroupCreateResourceID(organizationID, groupID string) string {
+	parts := []string{organizationID, groupID}
+	id := strings.Join(parts, groupResourceIDSeparator)
+
+	return id
+}
+
+func GroupParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATION-ID%[2]sGROUP-ID", id, groupResourceIDSeparator)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_workmail_mail_domain", name="Mail Domain")
+func ResourceMailDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMailDomainCreate,
+		ReadWithoutTimeout:   resourceMailDomainRead,
+		UpdateWithoutTimeout: resourceMailDomainUpdate,
+		DeleteWithoutTimeout: resourceMailDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"dkim_verification_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 209),
+			},
+			"is_test_domain": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ownership_verification_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMailDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID := d.Get("organization_id").(string)
+	domainName := d.Get("domain_name").(string)
+	id := MailDomainCreateResourceID(organizationID, domainName)
+	input := &workmail.RegisterMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	_, err := conn.RegisterMailDomainWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkMail Mail Domain (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("default").(bool) {
+		if err := updateDefaultMailDomain(ctx, conn, organizationID, domainName); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkMail Mail Domain (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMailDomainRead(ctx, d, meta)...)
+}
+
+func resourceMailDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, domainName, err := MailDomainParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, err := FindMailDomainByTwoPartKey(ctx, conn, organizationID, domainName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Mail Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkMail Mail Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set("default", domain.IsDefault)
+	d.Set("dkim_verification_status", domain.DkimVerificationStatus)
+	d.Set("domain_name", domainName)
+	d.Set("is_test_domain", domain.IsTestDomain)
+	d.Set("organization_id", organizationID)
+	d.Set("ownership_verification_status", domain.OwnershipVerificationStatus)
+	if err := d.Set("records", flattenDNSRecords(domain.Records)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
+	}
+
+	return diags
+}
+
+func resourceMailDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, domainName, err := MailDomainParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// The default mail domain can only be changed by making another domain the default.
+	if d.HasChange("default") && d.Get("default").(bool) {
+		if err := updateDefaultMailDomain(ctx, conn, organizationID, domainName); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkMail Mail Domain (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMailDomainRead(ctx, d, meta)...)
+}
+
+func resourceMailDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, domainName, err := MailDomainParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Mail Domain: %s", d.Id())
+	_, err = conn.DeregisterMailDomainWithContext(ctx, &workmail.DeregisterMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeMailDomainNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkMail Mail Domain (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const mailDomainResourceIDSeparator = ","
+
+func MailDomainCreateResourceID(organizationID, domainName string) string {
+	parts := []string{organizationID, domainName}
+	id := strings.Join(parts, mailDomainResourceIDSeparator)
+
+	return id
+}
+
+func MailDomainParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, mailDomainResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATION-ID%[2]sDOMAIN-NAME", id, mailDomainResourceIDSeparator)
+}
+
+func updateDefaultMailDomain(ctx context.Context, conn *workmail.WorkMail, organizationID, domainName string) error {
+	input := &workmail.UpdateDefaultMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	_, err := conn.UpdateDefaultMailDomainWithContext(ctx, input)
+
+	return err
+}
+
+func flattenDNSRecords(apiObjects []*workmail.DnsRecord) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hostname": aws.StringValue(apiObject.Hostname),
+			"type":     aws.StringValue(apiObject.Type),
+			"value":    aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workmail_organization", name="Organization")
+// @Tags(identifierAttribute="arn")
+func ResourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationCreate,
+		ReadWithoutTimeout:   resourceOrganizationRead,
+		UpdateWithoutTimeout: resourceOrganizationUpdate,
+		DeleteWithoutTimeout: resourceOrganizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 62),
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9](([a-z0-9-]*[a-z0-9])?)$`), "must contain only lowercase alphanumeric characters and hyphens, and must not begin or end with a hyphen"),
+				),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_mail_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_directory": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_interoperability": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	alias := d.Get("alias").(string)
+	input := &workmail.CreateOrganizationInput{
+		Alias:       aws.String(alias),
+		ClientToken: aws.String(id.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		input.DirectoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enable_interoperability"); ok {
+		input.EnableInteroperability = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateOrganizationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkMail Organization (%s): %s", alias, err)
+	}
+
+	d.SetId(aws.StringValue(output.OrganizationId))
+
+	organization, err := waitOrganizationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for WorkMail Organization (%s) create: %s", d.Id(), err)
+	}
+
+	if err := createTags(ctx, conn, aws.StringValue(organization.ARN), getTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting WorkMail Organization (%s) tags: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceOrganizationRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organization, err := FindOrganizationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Organization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkMail Organization (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias", organization.Alias)
+	d.Set("arn", organization.ARN)
+	d.Set("default_mail_domain", organization.DefaultMailDomain)
+	d.Set("directory_id", organization.DirectoryId)
+	d.Set("directory_type", organization.DirectoryType)
+	d.Set("state", organization.State)
+
+	return diags
+}
+
+func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceOrganizationRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkMail Organization: %s", d.Id())
+	_, err := conn.DeleteOrganizationWithContext(ctx, &workmail.DeleteOrganizationInput{
+		ClientToken:     aws.String(id.UniqueId()),
+		DeleteDirectory: aws.Bool(d.Get("delete_directory").(bool)),
+		OrganizationId:  aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeOrganizationNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkMail Organization (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for WorkMail Organization (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
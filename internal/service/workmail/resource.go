@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_workmail_resource", name="Resource")
+func ResourceResource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceCreate,
+		ReadWithoutTimeout:   resourceResourceRead,
+		UpdateWithoutTimeout: resourceResourceUpdate,
+		DeleteWithoutTimeout: resourceResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"booking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_accept_requests": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_decline_conflicting_requests": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_decline_recurring_requests": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(workmail.ResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateResourceInput{
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+		Type:           aws.String(d.Get("type").(string)),
+	}
+
+	output, err := conn.CreateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkMail Resource (%s): %s", name, err)
+	}
+
+	resourceID := aws.StringValue(output.ResourceId)
+	d.SetId(ResourceCreateResourceID(organizationID, resourceID))
+
+	if v, ok := d.GetOk("booking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &workmail.UpdateResourceInput{
+			BookingOptions: expandBookingOptions(v.([]interface{})[0].(map[string]interface{})),
+			OrganizationId: aws.String(organizationID),
+			ResourceId:     aws.String(resourceID),
+		}
+
+		_, err := conn.UpdateResourceWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkMail Resource (%s) booking options: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		if err := registerToWorkMail(ctx, conn, organizationID, resourceID, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "registering WorkMail Resource (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceRead(ctx, d, meta)...)
+}
+
+func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, resourceID, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resource, err := FindResourceByTwoPartKey(ctx, conn, organizationID, resourceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkMail Resource (%s): %s", d.Id(), err)
+	}
+
+	if resource.BookingOptions != nil {
+		if err := d.Set("booking_options", []interface{}{flattenBookingOptions(resource.BookingOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting booking_options: %s", err)
+		}
+	} else {
+		d.Set("booking_options", nil)
+	}
+	d.Set("email", resource.Email)
+	d.Set("name", resource.Name)
+	d.Set("organization_id", organizationID)
+	d.Set("resource_id", resource.ResourceId)
+	d.Set("state", resource.State)
+	d.Set("type", resource.Type)
+
+	return diags
+}
+
+func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, resourceID, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges("booking_options", "name") {
+		input := &workmail.UpdateResourceInput{
+			OrganizationId: aws.String(organizationID),
+			ResourceId:     aws.String(resourceID),
+		}
+
+		if d.HasChange("booking_options") {
+			if v, ok := d.GetOk("booking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.BookingOptions = expandBookingOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateResourceWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkMail Resource (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("email") {
+		o, n := d.GetChange("email")
+
+		if err := updateEntityEmail(ctx, conn, organizationID, resourceID, o.(string), n.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkMail Resource (%s) email: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceRead(ctx, d, meta)...)
+}
+
+func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, resourceID, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A resource must be deregistered before it can be deleted.
+	if d.Get("state").(string) == workmail.EntityStateEnabled {
+		if err := deregisterFromWorkMail(ctx, conn, organizationID, resourceID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deregistering WorkMail Resource (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Resource: %s", d.Id())
+	_, err = conn.DeleteResourceWithContext(ctx, &workmail.DeleteResourceInput{
+		OrganizationId: aws.String(organizationID),
+		ResourceId:     aws.String(resourceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkMail Resource (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const resourceResourceIDSeparator = ","
+
+func ResourceCreateResourceID(organizationID, resourceID string) string {
+	parts := []string{organizationID, resourceID}
+	id := strings.Join(parts, resourceResourceIDSeparator)
+
+	return id
+}
+
+func ResourceParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATION-ID%[2]sRESOURCE-ID", id, resourceResourceIDSeparator)
+}
+
+func expandBookingOptions(tfMap map[string]interface{}) *workmail.BookingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &workmail.BookingOptions{}
+
+	if v, ok := tfMap["auto_accept_requests"].(bool); ok {
+		apiObject.AutoAcceptRequests = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["auto_decline_conflicting_requests"].(bool); ok {
+		apiObject.AutoDeclineConflictingRequests = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["auto_decline_recurring_requests"].(bool); ok {
+		apiObject.AutoDeclineRecurringRequests = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenBookingOptions(apiObject *workmail.BookingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"auto_accept_requests":              aws.BoolValue(apiObject.AutoAcceptRequests),
+		"auto_decline_conflicting_requests": aws.BoolValue(apiObject.AutoDeclineConflictingRequests),
+		"auto_decline_recurring_requests":   aws.BoolValue(apiObject.AutoDeclineRecurringRequests),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailResource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName, "room1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_accept_requests", "true"),
+					resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("room1@%s.awsapps.com", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", "room1"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", workmail.EntityStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "type", workmail.ResourceTypeRoom),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceConfig_basic(rName, "room2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_accept_requests", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", "room2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailResource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName, "room1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceResource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_resource" {
+				continue
+			}
+
+			organizationID, resourceID, err := tfworkmail.ResourceParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfworkmail.FindResourceByTwoPartKey(ctx, conn, organizationID, resourceID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Resource %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Resource ID is set")
+		}
+
+		organizationID, resourceID, err := tfworkmail.ResourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn(ctx)
+
+		_, err = tfworkmail.FindResourceByTwoPartKey(ctx, conn, organizationID, resourceID)
+
+		return err
+	}
+}
+
+func testAccResourceConfig_basic(rName, name string, autoAccept bool) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_resource" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = %[1]q
+  type            = "ROOM"
+  email           = "room1@${aws_workmail_organization.test.default_mail_domain}"
+
+  booking_options {
+    auto_accept_requests = %[2]t
+  }
+}
+`, name, autoAccept))
+}
@@ -0,0 +1,76 @@
+// Code generated by internal/generate/servicepackages/main.go; DO NOT EDIT.
+
+package workmail
+
+import (
+	"context"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	workmail_sdkv1 "github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type servicePackage struct{}
+
+func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
+	return []*types.ServicePackageFrameworkDataSource{}
+}
+
+func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
+	return []*types.ServicePackageFrameworkResource{}
+}
+
+func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
+	return []*types.ServicePackageSDKDataSource{}
+}
+
+func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceGroup,
+			TypeName: "aws_workmail_group",
+			Name:     "Group",
+		},
+		{
+			Factory:  ResourceMailDomain,
+			TypeName: "aws_workmail_mail_domain",
+			Name:     "Mail Domain",
+		},
+		{
+			Factory:  ResourceOrganization,
+			TypeName: "aws_workmail_organization",
+			Name:     "Organization",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceResource,
+			TypeName: "aws_workmail_resource",
+			Name:     "Resource",
+		},
+		{
+			Factory:  ResourceUser,
+			TypeName: "aws_workmail_user",
+			Name:     "User",
+		},
+	}
+}
+
+func (p *servicePackage) ServicePackageName() string {
+	return names.WorkMail
+}
+
+// NewConn returns a new AWS SDK for Go v1 client for this service package's AWS API.
+func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*workmail_sdkv1.WorkMail, error) {
+	sess := config["session"].(*session_sdkv1.Session)
+
+	return workmail_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
+}
+
+func ServicePackage(ctx context.Context) conns.ServicePackage {
+	return &servicePackage{}
+}
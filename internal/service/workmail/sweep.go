@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package workmail
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_workmail_organization", &resource.Sweeper{
+		Name: "aws_workmail_organization",
+		F:    sweepOrganizations,
+	})
+}
+
+func sweepOrganizations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkMailConn(ctx)
+	input := &workmail.ListOrganizationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListOrganizationsPagesWithContext(ctx, input, func(page *workmail.ListOrganizationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OrganizationSummaries {
+			if aws.StringValue(v.State) == organizationStateDeleted {
+				continue
+			}
+
+			r := ResourceOrganization()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.OrganizationId))
+			d.Set("delete_directory", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkMail Organization sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkMail Organizations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkMail Organizations (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_workmail_user", name="User")
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserCreate,
+		ReadWithoutTimeout:   resourceUserRead,
+		UpdateWithoutTimeout: resourceUserUpdate,
+		DeleteWithoutTimeout: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateUserInput{
+		DisplayName:    aws.String(d.Get("display_name").(string)),
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+		Password:       aws.String(d.Get("password").(string)),
+	}
+
+	output, err := conn.CreateUserWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkMail User (%s): %s", name, err)
+	}
+
+	userID := aws.StringValue(output.UserId)
+	d.SetId(UserCreateResourceID(organizationID, userID))
+
+	if v, ok := d.GetOk("email"); ok {
+		if err := registerToWorkMail(ctx, conn, organizationID, userID, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "registering WorkMail User (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserRead(ctx, d, meta)...)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	user, err := FindUserByTwoPartKey(ctx, conn, organizationID, userID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkMail User (%s): %s", d.Id(), err)
+	}
+
+	d.Set("display_name", user.DisplayName)
+	d.Set("email", user.Email)
+	d.Set("name", user.Name)
+	d.Set("organization_id", organizationID)
+	d.Set("state", user.State)
+	d.Set("user_id", user.UserId)
+	d.Set("user_role", user.UserRole)
+
+	return diags
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange("email") {
+		o, n := d.GetChange("email")
+
+		if err := updateEntityEmail(ctx, conn, organizationID, userID, o.(string), n.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkMail User (%s) email: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("password") {
+		input := &workmail.ResetPasswordInput{
+			OrganizationId: aws.String(organizationID),
+			Password:       aws.String(d.Get("password").(string)),
+			UserId:         aws.String(userID),
+		}
+
+		_, err := conn.ResetPasswordWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resetting WorkMail User (%s) password: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserRead(ctx, d, meta)...)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkMailConn(ctx)
+
+	organizationID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A user must be deregistered before it can be deleted.
+	if d.Get("state").(string) == workmail.EntityStateEnabled {
+		if err := deregisterFromWorkMail(ctx, conn, organizationID, userID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deregistering WorkMail User (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail User: %s", d.Id())
+	_, err = conn.DeleteUserWithContext(ctx, &workmail.DeleteUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkMail User (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const userResourceIDSeparator = ","
+
+func UserCreateResourceID(organizationID, userID string) string {
+	parts := []string{organizationID, userID}
+	id := strings.Join(parts, userResourceIDSeparator)
+
+	return id
+}
+
+func UserParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATION-ID%[2]sUSER-ID", id, userResourceIDSeparator)
+}
+
+// registerToWorkMail registers an existing user, group, or resource with WorkMail, enabling its mailbox.
+func registerToWorkMail(ctx context.Context, conn *workmail.WorkMail, organizationID, entityID, email string) error {
+	input := &workmail.RegisterToWorkMailInput{
+		Email:          aws.String(email),
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	_, err := conn.RegisterToWorkMailWithContext(ctx, input)
+
+	return err
+}
+
+// deregisterFromWorkMail disables the mailbox of a user, group, or resource.
+func deregisterFromWorkMail(ctx context.Context, conn *workmail.WorkMail, organizationID, entityID string) error {
+	input := &workmail.DeregisterFromWorkMailInput{
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	_, err := conn.DeregisterFromWorkMailWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeEntityStateException) {
+		return nil
+	}
+
+	return err
+}
+
+func updateEntityEmail(ctx context.Context, conn *workmail.WorkMail, organizationID, entityID, oldEmail, newEmail string) error {
+	switch {
+	case newEmail == "":
+		return deregisterFromWorkMail(ctx, conn, organizationID, entityID)
+	case oldEmail == "":
+		return registerToWorkMail(ctx, conn, organizationID, entityID, newEmail)
+	default:
+		input := &workmail.UpdatePrimaryEmailAddressInput{
+			Email:          aws.String(newEmail),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		}
+
+		_, err := conn.UpdatePrimaryEmailAddressWithContext(ctx, input)
+
+		return err
+	}
+}
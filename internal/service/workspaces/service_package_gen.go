@@ -69,6 +69,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceStandbyWorkspace,
+			TypeName: "aws_workspaces_standby_workspace",
+			Name:     "Standby Workspace",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceWorkspace,
 			TypeName: "aws_workspaces_workspace",
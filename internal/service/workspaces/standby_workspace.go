@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspaces_standby_workspace", name="Standby Workspace")
+// @Tags(identifierAttribute="id")
+func ResourceStandbyWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStandbyWorkspaceCreate,
+		ReadWithoutTimeout:   resourceStandbyWorkspaceRead,
+		UpdateWithoutTimeout: resourceStandbyWorkspaceUpdate,
+		DeleteWithoutTimeout: resourceStandbyWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"primary_workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(WorkspaceAvailableTimeout),
+			Delete: schema.DefaultTimeout(WorkspaceTerminatedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStandbyWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
+
+	input := types.StandbyWorkspace{
+		DirectoryId:        aws.String(d.Get("directory_id").(string)),
+		PrimaryWorkspaceId: aws.String(d.Get("primary_workspace_id").(string)),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		input.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	resp, err := conn.CreateStandbyWorkspaces(ctx, &workspaces.CreateStandbyWorkspacesInput{
+		PrimaryRegion:     aws.String(d.Get("primary_region").(string)),
+		StandbyWorkspaces: []types.StandbyWorkspace{input},
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Standby Workspace: %s", err)
+	}
+
+	wsFail := resp.FailedStandbyRequests
+	if len(wsFail) > 0 {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Standby Workspace: %s: %s", aws.ToString(wsFail[0].ErrorCode), aws.ToString(wsFail[0].ErrorMessage))
+	}
+
+	workspaceID := aws.ToString(resp.PendingStandbyRequests[0].WorkspaceId)
+	d.SetId(workspaceID)
+
+	_, err = WaitWorkspaceAvailable(ctx, conn, workspaceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Standby Workspace: waiting for completion: %s", err)
+	}
+
+	return append(diags, resourceStandbyWorkspaceRead(ctx, d, meta)...)
+}
+
+func resourceStandbyWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
+
+	rawOutput, state, err := StatusWorkspaceState(ctx, conn, d.Id())()
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Standby Workspace (%s): %s", d.Id(), err)
+	}
+	if state == string(types.WorkspaceStateTerminated) {
+		log.Printf("[WARN] WorkSpaces Standby Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	workspace := rawOutput.(types.Workspace)
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("ip_address", workspace.IpAddress)
+	for _, v := range workspace.RelatedWorkspaces {
+		if v.Type == types.StandbyWorkspaceRelationshipTypePrimary {
+			d.Set("primary_region", v.Region)
+			d.Set("primary_workspace_id", v.WorkspaceId)
+		}
+	}
+	d.Set("state", workspace.State)
+	d.Set("user_name", workspace.UserName)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	return diags
+}
+
+func resourceStandbyWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceStandbyWorkspaceRead(ctx, d, meta)
+}
+
+func resourceStandbyWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
+
+	if err := WorkspaceDelete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	envVarStandbyPrimaryWorkspaceID = "WORKSPACES_STANDBY_PRIMARY_WORKSPACE_ID"
+	envVarStandbyDirectoryID        = "WORKSPACES_STANDBY_DIRECTORY_ID"
+)
+
+func testAccStandbyWorkspace_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	primaryWorkspaceID := envvar.SkipIfEmpty(t, envVarStandbyPrimaryWorkspaceID, "ID of a WorkSpace in the alternate region to create a standby WorkSpace for")
+	directoryID := envvar.SkipIfEmpty(t, envVarStandbyDirectoryID, "ID of a WorkSpaces directory in the current region configured for multi-Region resilience")
+	var v types.Workspace
+	resourceName := "aws_workspaces_standby_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStandbyWorkspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStandbyWorkspaceConfig_basic(primaryWorkspaceID, directoryID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttr(resourceName, "primary_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "primary_workspace_id", primaryWorkspaceID),
+					resource.TestCheckResourceAttr(resourceName, "state", string(types.WorkspaceStateAvailable)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStandbyWorkspaceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspaces_standby_workspace" {
+				continue
+			}
+
+			resp, err := conn.DescribeWorkspaces(ctx, &workspaces.DescribeWorkspacesInput{
+				WorkspaceIds: []string{rs.Primary.ID},
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(resp.Workspaces) == 0 {
+				return nil
+			}
+			ws := resp.Workspaces[0]
+
+			if ws.State != types.WorkspaceStateTerminating && ws.State != types.WorkspaceStateTerminated {
+				return fmt.Errorf("standby workspace %q was not terminated", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStandbyWorkspaceConfig_basic(primaryWorkspaceID, directoryID string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_standby_workspace" "test" {
+  directory_id         = %[2]q
+  primary_region       = %[3]q
+  primary_workspace_id = %[1]q
+}
+`, primaryWorkspaceID, directoryID, acctest.AlternateRegion())
+}
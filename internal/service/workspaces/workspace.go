@@ -57,6 +57,30 @@ func ResourceWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"related_workspaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"workspace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"root_volume_encryption_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -210,6 +234,9 @@ func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("ip_address", workspace.IpAddress)
 	d.Set("computer_name", workspace.ComputerName)
 	d.Set("state", workspace.State)
+	if err := d.Set("related_workspaces", flattenRelatedWorkspaces(workspace.RelatedWorkspaces)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting related_workspaces: %s", err)
+	}
 	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
 	d.Set("user_name", workspace.UserName)
 	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
@@ -387,6 +414,25 @@ func FlattenWorkspaceProperties(properties *types.WorkspaceProperties) []map[str
 	}
 }
 
+func flattenRelatedWorkspaces(apiObjects []types.RelatedWorkspaceProperties) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"region":       aws.ToString(apiObject.Region),
+			"state":        string(apiObject.State),
+			"type":         string(apiObject.Type),
+			"workspace_id": aws.ToString(apiObject.WorkspaceId),
+		})
+	}
+
+	return tfList
+}
+
 func flattenComputeEnumValues(t []types.Compute) []string {
 	var out []string
 
@@ -46,6 +46,30 @@ func DataSourceWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"related_workspaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"workspace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"root_volume_encryption_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -157,6 +181,9 @@ func dataSourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("ip_address", workspace.IpAddress)
 	d.Set("computer_name", workspace.ComputerName)
 	d.Set("state", workspace.State)
+	if err := d.Set("related_workspaces", flattenRelatedWorkspaces(workspace.RelatedWorkspaces)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting related_workspaces: %s", err)
+	}
 	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
 	d.Set("user_name", workspace.UserName)
 	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
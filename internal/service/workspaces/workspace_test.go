@@ -52,6 +52,7 @@ func testAccWorkspace_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "bundle_id", bundleDataSourceName, "id"),
 					resource.TestMatchResourceAttr(resourceName, "ip_address", regexp.MustCompile(`\d+\.\d+\.\d+\.\d+`)),
 					resource.TestCheckResourceAttr(resourceName, "state", string(types.WorkspaceStateAvailable)),
+					resource.TestCheckResourceAttr(resourceName, "related_workspaces.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "root_volume_encryption_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "user_name", "Administrator"),
 					resource.TestCheckResourceAttr(resourceName, "volume_encryption_key", ""),
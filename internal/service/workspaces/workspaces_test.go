@@ -30,6 +30,9 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			"multipleDirectories": testAccIPGroup_MultipleDirectories,
 			"tags":                testAccIPGroup_tags,
 		},
+		"StandbyWorkspace": {
+			"basic": testAccStandbyWorkspace_basic,
+		},
 		"Workspace": {
 			"basic":                  testAccWorkspace_basic,
 			"recreate":               testAccWorkspace_recreate,
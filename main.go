@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -38,6 +39,10 @@ func main() {
 		serveOpts...,
 	)
 
+	if err := conns.ShutdownClientMetrics(context.Background()); err != nil {
+		log.Printf("[WARN] shutting down AWS API client metrics: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -109,6 +109,7 @@ const (
 	IoT                          = "iot"
 	IoTAnalytics                 = "iotanalytics"
 	IoTEvents                    = "iotevents"
+	IoTSiteWise                  = "iotsitewise"
 	KMS                          = "kms"
 	Kafka                        = "kafka"
 	KafkaConnect                 = "kafkaconnect"
@@ -155,6 +156,7 @@ const (
 	Redshift                     = "redshift"
 	RedshiftData                 = "redshiftdata"
 	RedshiftServerless           = "redshiftserverless"
+	ResilienceHub                = "resiliencehub"
 	ResourceExplorer2            = "resourceexplorer2"
 	ResourceGroups               = "resourcegroups"
 	ResourceGroupsTaggingAPI     = "resourcegroupstaggingapi"
@@ -202,6 +204,7 @@ const (
 	WAFRegional                  = "wafregional"
 	WAFV2                        = "wafv2"
 	WorkLink                     = "worklink"
+	WorkMail                     = "workmail"
 	WorkSpaces                   = "workspaces"
 	XRay                         = "xray"
 )